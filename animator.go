@@ -0,0 +1,369 @@
+package terminus
+
+import "sort"
+
+// AnimationMode is how a Clip plays once it reaches
+// its last frame
+type AnimationMode int
+
+// Animation Modes
+const (
+	// AnimationLoop starts again from the first frame
+	AnimationLoop AnimationMode = iota
+
+	// AnimationPingPong plays backwards to the first
+	// frame, then forwards again
+	AnimationPingPong
+
+	// AnimationOnce stops on the last frame
+	AnimationOnce
+)
+
+// AnimationFrame is a single frame of a Clip. The Entity
+// is drawn as Sprite if it is set, otherwise as Rune
+type AnimationFrame struct {
+	Rune     rune
+	Sprite   *Sprite
+	Duration float64
+}
+
+// Clip is a named series of frames, such as a walk
+// cycle or an explosion
+type Clip struct {
+	name     string
+	mode     AnimationMode
+	frames   []AnimationFrame
+	callback func()
+}
+
+// NewClip creates a Clip from frames, each with its own
+// duration in seconds
+func NewClip(name string, mode AnimationMode, frames ...AnimationFrame) *Clip {
+
+	clip := &Clip{
+		name:   name,
+		mode:   mode,
+		frames: frames,
+	}
+
+	return clip
+
+}
+
+// NewRuneClip creates a Clip which shows each rune for
+// duration seconds
+func NewRuneClip(name string, mode AnimationMode, duration float64, runes ...rune) *Clip {
+
+	frames := make([]AnimationFrame, len(runes))
+
+	for i, r := range runes {
+		frames[i] = AnimationFrame{Rune: r, Duration: duration}
+	}
+
+	return NewClip(name, mode, frames...)
+
+}
+
+// NewSpriteClip creates a Clip which shows each Sprite
+// for duration seconds
+func NewSpriteClip(name string, mode AnimationMode, duration float64, sprites ...*Sprite) *Clip {
+
+	frames := make([]AnimationFrame, len(sprites))
+
+	for i, sprite := range sprites {
+		frames[i] = AnimationFrame{Sprite: sprite, Duration: duration}
+	}
+
+	return NewClip(name, mode, frames...)
+
+}
+
+// OnFinish sets a callback which fires when a Clip
+// played once reaches its end, and each time a looping
+// Clip completes a cycle
+func (clip *Clip) OnFinish(callback func()) {
+	clip.callback = callback
+}
+
+// GetName gets the name of the Clip
+func (clip *Clip) GetName() string {
+	return clip.name
+}
+
+// GetMode gets the AnimationMode of the Clip
+func (clip *Clip) GetMode() AnimationMode {
+	return clip.mode
+}
+
+// GetFrames gets the frames of the Clip
+func (clip *Clip) GetFrames() []AnimationFrame {
+	return clip.frames
+}
+
+// Animator plays the clips of an Entity, changing the
+// way the Entity is drawn as time passes. It is driven
+// by the delta passed to Entity.Update, so entities
+// which override Update must call the super Update
+type Animator struct {
+	entity *Entity
+	clips  map[string]*Clip
+
+	current   *Clip
+	frame     int
+	direction int
+	elapsed   float64
+	playing   bool
+	finished  bool
+	speed     float64
+}
+
+// newAnimator creates an Animator for entity
+func newAnimator(entity *Entity) *Animator {
+
+	animator := &Animator{
+		entity: entity,
+		clips:  map[string]*Clip{},
+		speed:  1,
+	}
+
+	return animator
+
+}
+
+// Add adds clip, replacing any Clip with the same name
+func (animator *Animator) Add(clip *Clip) {
+	animator.clips[clip.name] = clip
+}
+
+// Remove removes the Clip with the given name, stopping
+// it if it is playing
+func (animator *Animator) Remove(name string) {
+
+	if nil != animator.current && name == animator.current.name {
+		animator.current = nil
+		animator.playing = false
+	}
+
+	delete(animator.clips, name)
+
+}
+
+// Get gets the Clip with the given name. If there is
+// none ok returns false
+func (animator *Animator) Get(name string) (*Clip, bool) {
+
+	clip, ok := animator.clips[name]
+
+	return clip, ok
+
+}
+
+// Clips returns the names of the clips, sorted
+// alphabetically
+func (animator *Animator) Clips() []string {
+
+	names := make([]string, 0, len(animator.clips))
+
+	for name := range animator.clips {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+
+}
+
+// Play plays the Clip with the given name from its
+// first frame. If the Clip is already playing it
+// carries on, so Play can be called on every update.
+// If there is no Clip with the name ok returns false
+func (animator *Animator) Play(name string) bool {
+
+	clip, ok := animator.clips[name]
+
+	if !ok {
+		return false
+	}
+
+	if clip == animator.current && animator.playing {
+		return true
+	}
+
+	animator.current = clip
+	animator.Restart()
+
+	return true
+
+}
+
+// Restart plays the current Clip again from its
+// first frame
+func (animator *Animator) Restart() {
+
+	if nil == animator.current {
+		return
+	}
+
+	animator.frame = 0
+	animator.direction = 1
+	animator.elapsed = 0
+	animator.playing = true
+	animator.finished = false
+
+	animator.apply()
+
+}
+
+// Stop pauses the current Clip on the frame that
+// it is showing
+func (animator *Animator) Stop() {
+	animator.playing = false
+}
+
+// Resume carries on playing the current Clip after
+// it has been stopped
+func (animator *Animator) Resume() {
+
+	if nil != animator.current && !animator.finished {
+		animator.playing = true
+	}
+
+}
+
+// IsPlaying checks if a Clip is playing
+func (animator *Animator) IsPlaying() bool {
+	return animator.playing
+}
+
+// IsFinished checks if the current Clip was played
+// once and has reached its end
+func (animator *Animator) IsFinished() bool {
+	return animator.finished
+}
+
+// GetClip gets the current Clip, or nil if none has
+// been played
+func (animator *Animator) GetClip() *Clip {
+	return animator.current
+}
+
+// GetFrame gets the index of the frame being shown
+func (animator *Animator) GetFrame() int {
+	return animator.frame
+}
+
+// SetSpeed sets how fast clips play. 1 is normal speed,
+// 2 is twice as fast and 0.5 is half as fast
+func (animator *Animator) SetSpeed(speed float64) {
+	animator.speed = speed
+}
+
+// GetSpeed gets how fast clips play
+func (animator *Animator) GetSpeed() float64 {
+	return animator.speed
+}
+
+// update advances the current Clip by delta seconds.
+// A frame without a duration is shown for a single
+// update
+func (animator *Animator) update(delta float64) {
+
+	if !animator.playing || 0 == len(animator.current.frames) {
+		return
+	}
+
+	animator.elapsed += delta * animator.speed
+	frame := animator.frame
+
+	for animator.playing {
+
+		duration := animator.current.frames[animator.frame].Duration
+
+		if duration > 0 && animator.elapsed < duration {
+			break
+		}
+
+		if duration > 0 {
+			animator.elapsed -= duration
+		}
+
+		clip := animator.current
+		animator.advance()
+
+		// the callback may have played another clip
+		if duration <= 0 || clip != animator.current {
+			break
+		}
+
+	}
+
+	if frame != animator.frame {
+		animator.apply()
+	}
+
+}
+
+// advance moves to the next frame of the current Clip
+func (animator *Animator) advance() {
+
+	clip := animator.current
+	last := len(clip.frames) - 1
+
+	switch {
+	case AnimationOnce == clip.mode && animator.frame >= last:
+
+		animator.playing = false
+		animator.finished = true
+		animator.elapsed = 0
+
+	case AnimationPingPong == clip.mode && last > 0:
+
+		if next := animator.frame + animator.direction; next < 0 || next > last {
+			animator.direction = -animator.direction
+		}
+
+		animator.frame += animator.direction
+
+		// a cycle ends back on the first frame
+		if 0 != animator.frame {
+			return
+		}
+
+	case animator.frame < last:
+
+		animator.frame++
+		return
+
+	default:
+		animator.frame = 0
+	}
+
+	if nil != clip.callback {
+		clip.callback()
+	}
+
+}
+
+// apply draws the Entity as the current frame
+func (animator *Animator) apply() {
+
+	if 0 == len(animator.current.frames) {
+		return
+	}
+
+	entity := animator.entity
+	frame := animator.current.frames[animator.frame]
+
+	entity.multiSprite = frame.Sprite
+
+	if nil == frame.Sprite {
+		entity.sprite = frame.Rune
+		entity.combining = nil
+	}
+
+	if nil != entity.scene {
+		entity.scene.redraw = true
+	}
+
+}
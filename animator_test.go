@@ -0,0 +1,112 @@
+package terminus
+
+import (
+	"testing"
+)
+
+func TestAnimatorFrames(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		mode     AnimationMode
+		duration float64
+		speed    float64
+		deltas   []float64
+		want     string
+		finishes int
+		finished bool
+	}{
+		{"waits for the duration", AnimationLoop, 0.25, 1, []float64{0.125, 0.125, 0.125}, "abb", 0, false},
+		{"loops", AnimationLoop, 0.25, 1, []float64{0.25, 0.25, 0.25, 0.25}, "bcab", 1, false},
+		{"skips frames", AnimationLoop, 0.25, 1, []float64{0.5, 0.5}, "cb", 1, false},
+		{"ping pong", AnimationPingPong, 0.25, 1, []float64{0.25, 0.25, 0.25, 0.25, 0.25}, "bcbab", 1, false},
+		{"once", AnimationOnce, 0.25, 1, []float64{0.25, 0.25, 0.25, 0.25}, "bccc", 1, true},
+		{"frames without a duration", AnimationLoop, 0, 1, []float64{1, 1, 1}, "bca", 1, false},
+		{"speed", AnimationLoop, 0.25, 2, []float64{0.125, 0.125}, "bc", 0, false},
+	}
+
+	for _, test := range tests {
+
+		e := NewEntity(0, 0)
+		animator := e.Animator()
+		animator.SetSpeed(test.speed)
+
+		finishes := 0
+		clip := NewRuneClip("clip", test.mode, test.duration, 'a', 'b', 'c')
+		clip.OnFinish(func() { finishes++ })
+
+		animator.Add(clip)
+		animator.Play("clip")
+
+		if 'a' != e.GetSprite() {
+			t.Errorf("%s: Play shows %q, want 'a'", test.name, e.GetSprite())
+		}
+
+		got := ""
+
+		for _, delta := range test.deltas {
+
+			e.Update(delta)
+			got += string(e.GetSprite())
+
+		}
+
+		if got != test.want {
+			t.Errorf("%s: frames %q, want %q", test.name, got, test.want)
+		}
+
+		if finishes != test.finishes {
+			t.Errorf("%s: OnFinish fired %d times, want %d", test.name, finishes, test.finishes)
+		}
+
+		if animator.IsFinished() != test.finished {
+			t.Errorf("%s: IsFinished = %v, want %v", test.name, animator.IsFinished(), test.finished)
+		}
+
+	}
+
+}
+
+func TestAnimatorStopAndResume(t *testing.T) {
+
+	e := NewEntity(0, 0)
+	animator := e.Animator()
+	animator.Add(NewRuneClip("clip", AnimationLoop, 0.25, 'a', 'b', 'c'))
+	animator.Play("clip")
+
+	e.Update(0.25)
+	animator.Stop()
+	e.Update(0.25)
+
+	if 'b' != e.GetSprite() {
+		t.Errorf("stopped on %q, want 'b'", e.GetSprite())
+	}
+
+	animator.Resume()
+
+	// Play carries on with a clip that is playing
+	animator.Play("clip")
+	e.Update(0.25)
+
+	if 'c' != e.GetSprite() {
+		t.Errorf("resumed on %q, want 'c'", e.GetSprite())
+	}
+
+}
+
+func TestEntityGroupUpdatesAnimators(t *testing.T) {
+
+	child := NewEntity(0, 0)
+	child.Animator().Add(NewRuneClip("clip", AnimationLoop, 0.25, 'a', 'b'))
+	child.Animator().Play("clip")
+
+	inner := NewEntityGroup(0, 0, 1, 1, []IEntity{child})
+	outer := NewEntityGroup(0, 0, 1, 1, []IEntity{inner})
+
+	outer.Update(0.25)
+
+	if 'b' != child.GetSprite() {
+		t.Errorf("child of a group shows %q after an update, want 'b'", child.GetSprite())
+	}
+
+}
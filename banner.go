@@ -0,0 +1,128 @@
+package terminus
+
+// BannerText is a type of Text which draws its text
+// in large letters with a FIGlet Font. It has the
+// same colors, styles, markup, gradients and box
+// layout as Text, which apply to the banner as it is
+// drawn on the screen
+type BannerText struct {
+	*Text
+	banner     string
+	source     []grapheme
+	font       *Font
+	fontLayout FontLayout
+}
+
+// NewBannerText takes an x position, y position, text
+// value and Font and creates a new BannerText Entity
+// on the screen. If font is nil FontBlock is used
+func NewBannerText(x, y int, text string, font *Font, colors ...Color) *BannerText {
+
+	if nil == font {
+		font = FontBlock
+	}
+
+	b := &BannerText{
+		Text: NewText(x, y, "", colors...),
+		font: font,
+	}
+
+	b.SetText(text)
+
+	return b
+
+}
+
+// NewRichBannerText creates a new BannerText from text
+// with markup tags. Each character of the banner has
+// the Style of the character it is drawn from. See
+// Text.SetMarkup
+func NewRichBannerText(x, y int, markup string, font *Font, colors ...Color) *BannerText {
+
+	b := NewBannerText(x, y, "", font, colors...)
+	b.SetMarkup(markup)
+
+	return b
+
+}
+
+// SetText sets the text value of the BannerText
+func (b *BannerText) SetText(newText string) {
+
+	b.banner = newText
+	b.source = graphemes(newText)
+	b.markup = ""
+	b.render()
+
+}
+
+// GetText gets the text value of the BannerText,
+// without any markup tags
+func (b *BannerText) GetText() string {
+	return b.banner
+}
+
+// SetMarkup sets the text value of the BannerText from
+// text with markup tags. See Text.SetMarkup
+func (b *BannerText) SetMarkup(markup string) {
+
+	b.source = parseMarkup(markup)
+	b.banner = graphemesText(b.source)
+	b.markup = markup
+	b.render()
+
+}
+
+// SetFont sets the Font the BannerText is drawn with
+func (b *BannerText) SetFont(font *Font) {
+
+	if nil == font {
+		return
+	}
+
+	b.font = font
+	b.render()
+
+}
+
+// GetFont gets the Font the BannerText is drawn with
+func (b *BannerText) GetFont() *Font {
+	return b.font
+}
+
+// SetFontLayout sets how the characters of the Font
+// are fitted together
+func (b *BannerText) SetFontLayout(layout FontLayout) {
+
+	b.fontLayout = layout
+	b.render()
+
+}
+
+// GetFontLayout gets how the characters of the Font
+// are fitted together
+func (b *BannerText) GetFontLayout() FontLayout {
+	return b.fontLayout
+}
+
+// render draws the text with the Font, and lays out
+// the rows of the banner as the lines of the Text
+func (b *BannerText) render() {
+
+	chars := []grapheme{}
+
+	for i, row := range b.font.render(b.source, b.fontLayout) {
+
+		if i > 0 {
+			chars = append(chars, grapheme{r: '\n', width: 1})
+		}
+
+		chars = append(chars, row...)
+
+	}
+
+	b.chars = chars
+	b.text = graphemesText(chars)
+	b.layout()
+
+}
@@ -0,0 +1,243 @@
+package terminus
+
+import "math"
+
+// Camera controls which part of a scene's world is
+// shown on the screen. Entities keep their world
+// positions, and are drawn offset by the position
+// of the Camera
+type Camera struct {
+	scene *Scene
+
+	x float64
+	y float64
+
+	target IEntity
+	snap   bool
+
+	deadZoneWidth  int
+	deadZoneHeight int
+
+	bounded      bool
+	boundsX      int
+	boundsY      int
+	boundsWidth  int
+	boundsHeight int
+
+	smoothing float64
+}
+
+// NewCamera creates a Camera at the world origin. Use
+// Scene.SetCamera to attach it to a scene
+func NewCamera() *Camera {
+
+	camera := &Camera{}
+
+	return camera
+
+}
+
+// SetPosition moves the top left corner of the Camera
+// to the world position x, y
+func (camera *Camera) SetPosition(x, y int) {
+
+	camera.x, camera.y = float64(x), float64(y)
+	camera.clamp()
+	camera.setRedraw()
+
+}
+
+// GetPosition returns the world position of the top
+// left corner of the Camera
+func (camera *Camera) GetPosition() (int, int) {
+	return int(math.Round(camera.x)), int(math.Round(camera.y))
+}
+
+// CenterOn moves the Camera so that the world position
+// x, y is in the center of the screen
+func (camera *Camera) CenterOn(x, y int) {
+
+	width, height := camera.screenSize()
+	camera.SetPosition(x-width/2, y-height/2)
+
+}
+
+// Follow makes the Camera follow target, starting on
+// the next update. Pass nil to stop following
+func (camera *Camera) Follow(target IEntity) {
+
+	camera.target = target
+	camera.snap = true
+
+}
+
+// GetTarget gets the entity that the Camera follows,
+// or nil if there is none
+func (camera *Camera) GetTarget() IEntity {
+	return camera.target
+}
+
+// SetDeadZone sets the size of the area in the center
+// of the screen where the target can move without the
+// Camera following it. The default of 0, 0 keeps the
+// target centered
+func (camera *Camera) SetDeadZone(width, height int) {
+	camera.deadZoneWidth, camera.deadZoneHeight = width, height
+}
+
+// GetDeadZone gets the width and height of the dead zone
+func (camera *Camera) GetDeadZone() (int, int) {
+	return camera.deadZoneWidth, camera.deadZoneHeight
+}
+
+// SetBounds keeps the Camera inside of the world area
+// at x, y of the given size, so that nothing beyond
+// the edges of a map is shown
+func (camera *Camera) SetBounds(x, y, width, height int) {
+
+	camera.bounded = true
+	camera.boundsX, camera.boundsY = x, y
+	camera.boundsWidth, camera.boundsHeight = width, height
+
+	camera.clamp()
+	camera.setRedraw()
+
+}
+
+// ClearBounds lets the Camera move anywhere
+func (camera *Camera) ClearBounds() {
+	camera.bounded = false
+}
+
+// SetSmoothing sets how quickly the Camera catches up
+// with its target. Each second the Camera moves roughly
+// smoothing times the remaining distance, so higher
+// values are faster. The default of 0 follows the
+// target exactly
+func (camera *Camera) SetSmoothing(smoothing float64) {
+	camera.smoothing = smoothing
+}
+
+// GetSmoothing gets the smoothing of the Camera
+func (camera *Camera) GetSmoothing() float64 {
+	return camera.smoothing
+}
+
+// WorldToScreen converts a world position into a
+// screen position
+func (camera *Camera) WorldToScreen(x, y int) (int, int) {
+
+	cx, cy := camera.GetPosition()
+
+	return x - cx, y - cy
+
+}
+
+// ScreenToWorld converts a screen position, such as
+// the position of the mouse, into a world position
+func (camera *Camera) ScreenToWorld(x, y int) (int, int) {
+
+	cx, cy := camera.GetPosition()
+
+	return x + cx, y + cy
+
+}
+
+// update moves the Camera towards its target. The game
+// calls this after each update of the current scene
+func (camera *Camera) update(delta float64) {
+
+	if nil == camera.target {
+		return
+	}
+
+	cx, cy := camera.GetPosition()
+	tx, ty := camera.target.GetEntity().GetWorldPosition()
+	width, height := camera.screenSize()
+
+	// the dead zone is centered on the screen, and the
+	// camera only moves once the target leaves it
+	left := camera.x + float64((width-camera.deadZoneWidth)/2)
+	top := camera.y + float64((height-camera.deadZoneHeight)/2)
+
+	x := camera.x + deadZoneShift(float64(tx), left, camera.deadZoneWidth)
+	y := camera.y + deadZoneShift(float64(ty), top, camera.deadZoneHeight)
+
+	if camera.smoothing > 0 && !camera.snap {
+
+		weight := math.Min(1, camera.smoothing*delta)
+
+		x = camera.x + (x-camera.x)*weight
+		y = camera.y + (y-camera.y)*weight
+
+	}
+
+	camera.x, camera.y = x, y
+	camera.snap = false
+	camera.clamp()
+
+	if nx, ny := camera.GetPosition(); nx != cx || ny != cy {
+		camera.setRedraw()
+	}
+
+}
+
+// deadZoneShift returns how far the Camera must move
+// along one axis to bring target back into a dead zone
+// of size cells, starting at start
+func deadZoneShift(target, start float64, size int) float64 {
+
+	end := start + float64(size) - 1
+
+	if 0 == size {
+		end = start
+	}
+
+	if target < start {
+		return target - start
+	}
+
+	if target > end {
+		return target - end
+	}
+
+	return 0
+
+}
+
+// clamp keeps the Camera inside of its bounds. When
+// the bounds are smaller than the screen, the Camera
+// stays at their top left corner
+func (camera *Camera) clamp() {
+
+	if !camera.bounded {
+		return
+	}
+
+	width, height := camera.screenSize()
+
+	camera.x = math.Max(float64(camera.boundsX), math.Min(camera.x, float64(camera.boundsX+camera.boundsWidth-width)))
+	camera.y = math.Max(float64(camera.boundsY), math.Min(camera.y, float64(camera.boundsY+camera.boundsHeight-height)))
+
+}
+
+// screenSize returns the size of the screen that the
+// Camera is shown on
+func (camera *Camera) screenSize() (int, int) {
+
+	if nil == camera.scene {
+		return 0, 0
+	}
+
+	return camera.scene.game.ScreenSize()
+
+}
+
+// setRedraw flags the Camera's scene for redraw
+func (camera *Camera) setRedraw() {
+
+	if nil != camera.scene {
+		camera.scene.redraw = true
+	}
+
+}
@@ -0,0 +1,345 @@
+package terminus
+
+// Border is the set of runes used to draw a box
+type Border struct {
+	Horizontal  rune
+	Vertical    rune
+	TopLeft     rune
+	TopRight    rune
+	BottomLeft  rune
+	BottomRight rune
+}
+
+// Box borders drawn with line drawing runes
+var (
+	BorderSingle  = Border{'─', '│', '┌', '┐', '└', '┘'}
+	BorderDouble  = Border{'═', '║', '╔', '╗', '╚', '╝'}
+	BorderRounded = Border{'─', '│', '╭', '╮', '╰', '╯'}
+	BorderASCII   = Border{'-', '|', '+', '+', '+', '+'}
+)
+
+// Canvas draws directly to the screen, for things that
+// don't need to be entities, such as backgrounds, frames
+// and debug visuals. It is immediate mode, so drawing is
+// only shown on the frame it was drawn on. Use it during
+// Draw, before drawing the scene's entities on top:
+//
+//	func (cs *CustomScene) Draw() {
+//		cs.Canvas().DrawBox(0, 0, 20, 10, t.BorderRounded, t.Style{})
+//		cs.Scene.Draw() // super
+//	}
+type Canvas struct {
+	scene   *Scene
+	offsetX int
+	offsetY int
+}
+
+// SetCell draws r at x, y. Points outside of the
+// screen are ignored
+func (canvas *Canvas) SetCell(x, y int, r rune, style Style) {
+	canvas.setContent(x, y, r, nil, style)
+}
+
+// setContent draws r, with the runes combined with
+// it, at x, y
+func (canvas *Canvas) setContent(x, y int, r rune, combining []rune, style Style) {
+
+	scene := canvas.scene
+
+	if 0 == r {
+		r = ' '
+	}
+
+	scene.game.screen.SetContent(x+canvas.offsetX, y+canvas.offsetY, r, combining, style.resolve(scene.style))
+
+	// drawing is only shown if the scene redraws
+	scene.redraw = true
+
+}
+
+// Size returns the width and height of the screen
+func (canvas *Canvas) Size() (int, int) {
+	return canvas.scene.game.screen.Size()
+}
+
+// DrawText draws text starting at x, y. Wide
+// characters take up two cells. A newline continues
+// the text on the next row, starting at x again
+func (canvas *Canvas) DrawText(x, y int, text string, style Style) {
+
+	col := x
+
+	for _, g := range graphemes(text) {
+
+		if '\n' == g.r {
+			col = x
+			y++
+			continue
+		}
+
+		canvas.setContent(col, y, g.r, g.combining, style)
+		col += g.width
+
+	}
+
+}
+
+// DrawLine draws a line of r from x0, y0 to x1, y1,
+// including both ends
+func (canvas *Canvas) DrawLine(x0, y0, x1, y1 int, r rune, style Style) {
+
+	// Bresenham's line algorithm, for every octant
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+
+	if x0 > x1 {
+		sx = -1
+	}
+
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+
+	for {
+
+		canvas.SetCell(x0, y0, r, style)
+
+		if x0 == x1 && y0 == y1 {
+			break
+		}
+
+		e2 := 2 * err
+
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+
+	}
+
+}
+
+// DrawRect draws the outline of a rectangle of r with
+// its top left corner at x, y
+func (canvas *Canvas) DrawRect(x, y, width, height int, r rune, style Style) {
+	canvas.DrawBox(x, y, width, height, Border{r, r, r, r, r, r}, style)
+}
+
+// FillRect fills a rectangle with r, with its top
+// left corner at x, y
+func (canvas *Canvas) FillRect(x, y, width, height int, r rune, style Style) {
+
+	for row := y; row < y+height; row++ {
+
+		for col := x; col < x+width; col++ {
+			canvas.SetCell(col, row, r, style)
+		}
+
+	}
+
+}
+
+// FillGradient fills a rectangle with r, with its top
+// left corner at x, y, and a background that blends
+// along gradient in the given direction
+func (canvas *Canvas) FillGradient(x, y, width, height int, r rune, style Style, gradient *Gradient, direction GradientDirection) {
+
+	for row := 0; row < height; row++ {
+
+		for col := 0; col < width; col++ {
+
+			amount := gradientAmount(col, width)
+
+			if GradientVertical == direction {
+				amount = gradientAmount(row, height)
+			}
+
+			canvas.SetCell(x+col, y+row, r, style.Background(gradient.At(amount)))
+
+		}
+
+	}
+
+}
+
+// Fill fills the whole screen with r
+func (canvas *Canvas) Fill(r rune, style Style) {
+
+	width, height := canvas.Size()
+	canvas.FillRect(-canvas.offsetX, -canvas.offsetY, width, height, r, style)
+
+}
+
+// DrawBox draws a box with the runes of border, with
+// its top left corner at x, y
+func (canvas *Canvas) DrawBox(x, y, width, height int, border Border, style Style) {
+
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	right, bottom := x+width-1, y+height-1
+
+	for col := x + 1; col < right; col++ {
+
+		canvas.SetCell(col, y, border.Horizontal, style)
+		canvas.SetCell(col, bottom, border.Horizontal, style)
+
+	}
+
+	for row := y + 1; row < bottom; row++ {
+
+		canvas.SetCell(x, row, border.Vertical, style)
+		canvas.SetCell(right, row, border.Vertical, style)
+
+	}
+
+	canvas.SetCell(x, y, border.TopLeft, style)
+	canvas.SetCell(right, y, border.TopRight, style)
+	canvas.SetCell(x, bottom, border.BottomLeft, style)
+	canvas.SetCell(right, bottom, border.BottomRight, style)
+
+}
+
+// DrawCircle draws the outline of a circle of r
+// centered on x, y. Terminal cells are about twice
+// as tall as they are wide, so use DrawEllipse with
+// radiusX twice radiusY for a circle that looks round
+func (canvas *Canvas) DrawCircle(x, y, radius int, r rune, style Style) {
+	canvas.DrawEllipse(x, y, radius, radius, r, style)
+}
+
+// FillCircle fills a circle with r, centered on x, y
+func (canvas *Canvas) FillCircle(x, y, radius int, r rune, style Style) {
+	canvas.FillEllipse(x, y, radius, radius, r, style)
+}
+
+// DrawEllipse draws the outline of an ellipse of r
+// centered on x, y
+func (canvas *Canvas) DrawEllipse(x, y, radiusX, radiusY int, r rune, style Style) {
+
+	ellipse(radiusX, radiusY, func(ex, ey int) {
+
+		canvas.SetCell(x+ex, y+ey, r, style)
+		canvas.SetCell(x-ex, y+ey, r, style)
+		canvas.SetCell(x+ex, y-ey, r, style)
+		canvas.SetCell(x-ex, y-ey, r, style)
+
+	})
+
+}
+
+// FillEllipse fills an ellipse with r, centered on x, y
+func (canvas *Canvas) FillEllipse(x, y, radiusX, radiusY int, r rune, style Style) {
+
+	if radiusX < 0 || radiusY < 0 {
+		return
+	}
+
+	// the widest point of the outline on each row
+	// gives the span to fill
+	spans := make([]int, radiusY+1)
+
+	ellipse(radiusX, radiusY, func(ex, ey int) {
+
+		if ex > spans[ey] {
+			spans[ey] = ex
+		}
+
+	})
+
+	for ey, span := range spans {
+
+		canvas.FillRect(x-span, y+ey, span*2+1, 1, r, style)
+
+		if 0 != ey {
+			canvas.FillRect(x-span, y-ey, span*2+1, 1, r, style)
+		}
+
+	}
+
+}
+
+// ellipse plots one quarter of the outline of an
+// ellipse centered on 0, 0 using the midpoint ellipse
+// algorithm. The other quarters are mirror images
+func ellipse(radiusX, radiusY int, plot func(x, y int)) {
+
+	if radiusX < 0 || radiusY < 0 {
+		return
+	}
+
+	if 0 == radiusY {
+
+		for x := 0; x <= radiusX; x++ {
+			plot(x, 0)
+		}
+
+		return
+
+	}
+
+	rx2, ry2 := float64(radiusX*radiusX), float64(radiusY*radiusY)
+	x, y := 0, radiusY
+	dx, dy := 0.0, 2*rx2*float64(y)
+
+	// region 1, where the slope is shallower than -1
+	d := ry2 - rx2*float64(radiusY) + rx2/4
+
+	for dx < dy {
+
+		plot(x, y)
+
+		x++
+		dx += 2 * ry2
+
+		if d < 0 {
+			d += dx + ry2
+		} else {
+			y--
+			dy -= 2 * rx2
+			d += dx - dy + ry2
+		}
+
+	}
+
+	// region 2, where the slope is steeper
+	fx, fy := float64(x)+0.5, float64(y-1)
+	d = ry2*fx*fx + rx2*fy*fy - rx2*ry2
+
+	for y >= 0 {
+
+		plot(x, y)
+
+		y--
+		dy -= 2 * rx2
+
+		if d > 0 {
+			d += rx2 - dy
+		} else {
+			x++
+			dx += 2 * ry2
+			d += dx - dy + rx2
+		}
+
+	}
+
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+
+	if n < 0 {
+		return -n
+	}
+
+	return n
+
+}
@@ -0,0 +1,242 @@
+package terminus
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// ColorRGB creates a true color from red, green and
+// blue values between 0 and 255. On terminals without
+// true color it is drawn as the closest color that
+// the terminal has
+func ColorRGB(r, g, b int) Color {
+	return tcell.NewRGBColor(int32(clampByte(r)), int32(clampByte(g)), int32(clampByte(b)))
+}
+
+// ColorHex creates a true color from a hex string, such
+// as "#ff8800", "ff8800" or the short form "#f80". It
+// returns ColorDefault if hex is not a valid color
+func ColorHex(hex string) Color {
+
+	hex = strings.TrimPrefix(hex, "#")
+
+	if 3 == len(hex) {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	if 6 != len(hex) {
+		return ColorDefault
+	}
+
+	v, err := strconv.ParseUint(hex, 16, 32)
+
+	if nil != err {
+		return ColorDefault
+	}
+
+	return tcell.NewHexColor(int32(v))
+
+}
+
+// ColorHSL creates a true color from a hue in degrees,
+// and a saturation and lightness between 0 and 1
+func ColorHSL(h, s, l float64) Color {
+
+	h = math.Mod(h, 360)
+
+	if h < 0 {
+		h += 360
+	}
+
+	s = math.Max(0, math.Min(1, s))
+	l = math.Max(0, math.Min(1, l))
+
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return ColorRGB(
+		int(math.Round((r+m)*255)),
+		int(math.Round((g+m)*255)),
+		int(math.Round((b+m)*255)),
+	)
+
+}
+
+// Palette returns color index of the xterm 256 color
+// palette. 0 to 15 are the standard colors, 16 to 231
+// a 6x6x6 color cube and 232 to 255 a grayscale ramp.
+// It returns ColorDefault if index is out of range
+func Palette(index int) Color {
+
+	if index < 0 || index > 255 {
+		return ColorDefault
+	}
+
+	return Color(index)
+
+}
+
+// LerpColor returns the color amount of the way from
+// from to to, where 0 is from and 1 is to. The result
+// is a true color
+func LerpColor(from, to Color, amount float64) Color {
+
+	amount = math.Max(0, math.Min(1, amount))
+
+	// the terminal's default colors have no value
+	// to blend, so pick whichever is closer
+	if from.Hex() < 0 || to.Hex() < 0 {
+
+		if amount < 0.5 {
+			return from
+		}
+
+		return to
+
+	}
+
+	r1, g1, b1 := from.RGB()
+	r2, g2, b2 := to.RGB()
+
+	return ColorRGB(
+		lerpByte(r1, r2, amount),
+		lerpByte(g1, g2, amount),
+		lerpByte(b1, b2, amount),
+	)
+
+}
+
+// BlendColor draws color over base with the given
+// opacity between 0 and 1, as if color was partly
+// transparent
+func BlendColor(base, color Color, opacity float64) Color {
+	return LerpColor(base, color, opacity)
+}
+
+// lerpByte interpolates between two color components
+func lerpByte(from, to int32, amount float64) int {
+	return int(math.Round(float64(from) + float64(to-from)*amount))
+}
+
+// clampByte keeps a color component between 0 and 255
+func clampByte(v int) int {
+
+	if v < 0 {
+		return 0
+	}
+
+	if v > 255 {
+		return 255
+	}
+
+	return v
+
+}
+
+// GradientDirection is the direction a gradient
+// is drawn in
+type GradientDirection int
+
+// Gradient Directions
+const (
+	GradientHorizontal GradientDirection = iota
+	GradientVertical
+)
+
+// Gradient is a smooth blend through a series of
+// colors, which are spread evenly along it
+type Gradient struct {
+	colors []Color
+}
+
+// NewGradient creates a Gradient which blends from
+// the first color through to the last
+func NewGradient(colors ...Color) *Gradient {
+
+	gradient := &Gradient{
+		colors: colors,
+	}
+
+	return gradient
+
+}
+
+// GetColors gets the colors the Gradient blends through
+func (gradient *Gradient) GetColors() []Color {
+	return gradient.colors
+}
+
+// At returns the color amount of the way along the
+// Gradient, where 0 is the start and 1 is the end
+func (gradient *Gradient) At(amount float64) Color {
+
+	switch len(gradient.colors) {
+	case 0:
+		return ColorDefault
+	case 1:
+		return gradient.colors[0]
+	}
+
+	amount = math.Max(0, math.Min(1, amount))
+
+	pos := amount * float64(len(gradient.colors)-1)
+	i := int(pos)
+
+	if i >= len(gradient.colors)-1 {
+		return gradient.colors[len(gradient.colors)-1]
+	}
+
+	return LerpColor(gradient.colors[i], gradient.colors[i+1], pos-float64(i))
+
+}
+
+// Steps returns n colors spread evenly along the
+// Gradient, including both ends
+func (gradient *Gradient) Steps(n int) []Color {
+
+	if n <= 0 {
+		return []Color{}
+	}
+
+	colors := make([]Color, n)
+
+	for i := range colors {
+		colors[i] = gradient.At(gradientAmount(i, n))
+	}
+
+	return colors
+
+}
+
+// gradientAmount returns how far along a gradient
+// step i of n steps is
+func gradientAmount(i, n int) float64 {
+
+	if n <= 1 {
+		return 0
+	}
+
+	return float64(i) / float64(n-1)
+
+}
@@ -0,0 +1,108 @@
+package terminus
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
+
+// maxRecentInputs is the number of input events kept
+// for crash reports
+const maxRecentInputs = 16
+
+// PanicError is returned by Start when the game panics.
+// It contains the recovered value and the stack trace
+// of the panic
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error implements the error interface
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("terminus: game panicked: %v", err.Value)
+}
+
+// newPanicError creates a PanicError for a recovered
+// value. It must be called from the deferred function
+// that recovered, so that the stack trace still points
+// at the panic
+func newPanicError(value interface{}) *PanicError {
+
+	return &PanicError{
+		Value: value,
+		Stack: debug.Stack(),
+	}
+
+}
+
+// recordInput keeps a short history of input events
+// for crash reports
+func (game *Game) recordInput(ev *KeyEvent) {
+
+	game.recentInputs = append(game.recentInputs, ev)
+
+	if len(game.recentInputs) > maxRecentInputs {
+		game.recentInputs = game.recentInputs[1:]
+	}
+
+}
+
+// crash restores the terminal and writes a crash
+// report for err to the log
+func (game *Game) crash(err *PanicError) {
+
+	game.fini()
+
+	game.logger.Print(game.crashReport(err))
+
+}
+
+// crashReport describes the state of the game when
+// err occurred
+func (game *Game) crashReport(err *PanicError) string {
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "CRASH: %v\n", err.Value)
+	fmt.Fprintf(&sb, "Scene index: %d\n", game.sceneIndex)
+	fmt.Fprintf(&sb, "Pushed scenes: %d\n", len(game.sceneStack))
+	fmt.Fprintf(&sb, "Entity count: %s\n", game.entityCount())
+
+	sb.WriteString("Recent input:")
+
+	if len(game.recentInputs) == 0 {
+		sb.WriteString(" none")
+	}
+
+	for _, ev := range game.recentInputs {
+		fmt.Fprintf(&sb, " %s", ev.Name())
+	}
+
+	sb.WriteString("\n")
+	sb.Write(err.Stack)
+
+	return sb.String()
+
+}
+
+// entityCount returns the number of entities in the
+// current scene. The scene may be what panicked, so
+// a second panic here is reported instead of raised
+func (game *Game) entityCount() (count string) {
+
+	defer func() {
+
+		if r := recover(); r != nil {
+			count = fmt.Sprintf("unknown (%v)", r)
+		}
+
+	}()
+
+	if game.sceneIndex < 0 || game.sceneIndex >= len(game.scenes) {
+		return "unknown (no current scene)"
+	}
+
+	return fmt.Sprint(len(game.current().Entities()))
+
+}
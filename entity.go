@@ -26,9 +26,20 @@ type Entity struct {
 	y      int
 	sprite rune
 
-	group *EntityGroup
+	// combining holds runes drawn in the same cell
+	// as sprite, such as accents
+	combining []rune
 
-	colors []tcell.Color
+	// multiSprite is drawn instead of sprite
+	// when it is set
+	multiSprite *Sprite
+
+	group       *EntityGroup
+	screenSpace bool
+	zIndex      int
+
+	style    Style
+	animator *Animator
 }
 
 // NewEntity takes an x position and a y position and
@@ -47,13 +58,28 @@ func NewEntity(x, y int) *Entity {
 // NewSpriteEntity takes an x position, a y position, and a rune
 // to be used as a visual representation, and creates an Entity
 // colors: optional - foreground, background required if used
-func NewSpriteEntity(x, y int, sprite rune, colors ...tcell.Color) *Entity {
+func NewSpriteEntity(x, y int, sprite rune, colors ...Color) *Entity {
 
 	entity := &Entity{
 		x:      x,
 		y:      y,
 		sprite: sprite,
-		colors: colors,
+		style:  colorsStyle(colors),
+	}
+
+	return entity
+
+}
+
+// NewMultiSpriteEntity takes an x position, a y position
+// and a Sprite, and creates an Entity which is drawn as
+// the Sprite, with its top left corner at x, y
+func NewMultiSpriteEntity(x, y int, sprite *Sprite) *Entity {
+
+	entity := &Entity{
+		x:           x,
+		y:           y,
+		multiSprite: sprite,
 	}
 
 	return entity
@@ -64,8 +90,16 @@ func NewSpriteEntity(x, y int, sprite rune, colors ...tcell.Color) *Entity {
 func (entity *Entity) Init() {}
 
 // Update fires after the scene update on each pass
-// through the game loop, and can be overridden
-func (entity *Entity) Update(delta float64) {}
+// through the game loop, and can be overridden. It
+// plays the Entity's Animator, so overrides should
+// call it as the super Update
+func (entity *Entity) Update(delta float64) {
+
+	if nil != entity.animator {
+		entity.animator.update(delta)
+	}
+
+}
 
 // Draw fires during scene.Draw and can be overridden.
 // Be careful, overridding this means that you will
@@ -73,27 +107,50 @@ func (entity *Entity) Update(delta float64) {}
 func (entity *Entity) Draw() {
 
 	screen := entity.game.screen
-	game := entity.game
-	currentScene := game.CurrentScene()
+	style := entity.style.resolve(entity.sceneStyle())
+
+	x, y := entity.GetScreenPosition()
+	width, height := entity.GetSize()
 
-	var style tcell.Style
+	if !entity.onScreen(x, y, width, height) {
+		return
+	}
 
-	if len(entity.colors) == 2 {
+	if nil != entity.multiSprite {
+		entity.multiSprite.draw(screen, x, y, entity.style, entity.sceneStyle(), nil)
+		return
+	}
 
-		style = tcell.StyleDefault.
-			Foreground(entity.colors[0]).
-			Background(entity.colors[1])
+	if 0 != entity.sprite {
+		screen.SetContent(x, y, entity.sprite, entity.combining, style)
+	}
 
-	} else {
+}
 
-		style = currentScene.style
+// colorsStyle returns the Style for the optional
+// foreground and background colors taken by entity
+// constructors. Both colors are required if used
+func colorsStyle(colors []Color) Style {
 
+	if len(colors) == 2 {
+		return NewStyle(colors[0], colors[1])
 	}
 
-	if 0 != entity.sprite {
-		screen.SetContent(entity.x, entity.y, entity.sprite, nil, style)
+	return Style{}
+
+}
+
+// sceneStyle returns the style of the scene that is
+// being drawn, which entities inherit anything that
+// is not set on their Style from
+func (entity *Entity) sceneStyle() tcell.Style {
+
+	if nil != entity.game.drawing {
+		return entity.game.drawing.style
 	}
 
+	return entity.scene.style
+
 }
 
 // GetEntity returns the entity in question
@@ -154,23 +211,95 @@ func (entity *Entity) GetPosition() (int, int) {
 	return entity.x, entity.y
 }
 
-// GetScreenPosition Gets the screen x and y for the
+// GetWorldPosition Gets the world x and y for the
 // Entity. This will be different than GetPosition
 // when the Entity is part of an EntityGroup
-func (entity *Entity) GetScreenPosition() (int, int) {
+func (entity *Entity) GetWorldPosition() (int, int) {
 
 	if nil == entity.group {
 		return entity.GetPosition()
 	}
 
-	groupX, groupY := entity.group.GetPosition()
+	groupX, groupY := entity.group.GetWorldPosition()
 	return entity.x + groupX, entity.y + groupY
 
 }
 
+// GetScreenPosition Gets the screen x and y for the
+// Entity. This is the world position as seen through
+// the Camera of the Entity's Scene, if it has one
+func (entity *Entity) GetScreenPosition() (int, int) {
+
+	x, y := entity.GetWorldPosition()
+
+	if entity.IsScreenSpace() || nil == entity.scene || nil == entity.scene.camera {
+		return x, y
+	}
+
+	return entity.scene.camera.WorldToScreen(x, y)
+
+}
+
+// SetScreenSpace sets whether the Entity is positioned
+// on the screen instead of in the world, so that it is
+// not moved by the Camera. Useful for HUDs and menus.
+// Entities in an EntityGroup follow the group
+func (entity *Entity) SetScreenSpace(screenSpace bool) {
+
+	entity.screenSpace = screenSpace
+
+	if nil != entity.scene {
+		entity.scene.redraw = true
+	}
+
+}
+
+// IsScreenSpace checks if the Entity is positioned on
+// the screen instead of in the world
+func (entity *Entity) IsScreenSpace() bool {
+
+	if nil != entity.group {
+		return entity.group.IsScreenSpace()
+	}
+
+	return entity.screenSpace
+
+}
+
+// SetZIndex sets the layer that the Entity is drawn
+// on. Entities with a higher z-index are drawn on top
+// of entities with a lower one, and entities with the
+// same z-index are drawn in the order they were added
+func (entity *Entity) SetZIndex(zIndex int) {
+
+	entity.zIndex = zIndex
+
+	if nil != entity.scene {
+		entity.scene.redraw = true
+	}
+
+}
+
+// GetZIndex gets the layer that the Entity is drawn on
+func (entity *Entity) GetZIndex() int {
+	return entity.zIndex
+}
+
+// onScreen checks if the area of the given size at
+// the screen position x, y is at least partly visible,
+// so that entities outside of the screen are culled
+func (entity *Entity) onScreen(x, y, width, height int) bool {
+
+	screenWidth, screenHeight := entity.game.screen.Size()
+
+	return x+width > 0 && x < screenWidth && y+height > 0 && y < screenHeight
+
+}
+
 // SetSprite sets the Entity's sprite rune
 func (entity *Entity) SetSprite(sprite rune) {
 	entity.sprite = sprite
+	entity.combining = nil
 	entity.scene.redraw = true
 }
 
@@ -179,25 +308,122 @@ func (entity *Entity) GetSprite() rune {
 	return entity.sprite
 }
 
+// SetMultiSprite sets the Sprite that the Entity is
+// drawn as, in place of its sprite rune. Pass nil to
+// go back to the sprite rune
+func (entity *Entity) SetMultiSprite(sprite *Sprite) {
+
+	entity.multiSprite = sprite
+
+	if nil != entity.scene {
+		entity.scene.redraw = true
+	}
+
+}
+
+// GetMultiSprite gets the Sprite that the Entity is
+// drawn as, or nil if it is drawn as a single rune
+func (entity *Entity) GetMultiSprite() *Sprite {
+	return entity.multiSprite
+}
+
+// GetSize returns the width and height that the Entity
+// covers, which is the size of its Sprite, or 1, 1 for
+// an Entity drawn as a single rune. Wide runes, such
+// as East Asian characters, are 2 cells wide
+func (entity *Entity) GetSize() (int, int) {
+
+	if nil != entity.multiSprite {
+		return entity.multiSprite.GetDimensions()
+	}
+
+	return runeWidth(entity.sprite), 1
+
+}
+
+// Animator returns the Animator which plays the clips
+// of the Entity, creating it on first use
+func (entity *Entity) Animator() *Animator {
+
+	if nil == entity.animator {
+		entity.animator = newAnimator(entity)
+	}
+
+	return entity.animator
+
+}
+
 // SetColor changes the entity's style foreground and
 // background colors
-func (entity *Entity) SetColor(fg, bg tcell.Color) {
+func (entity *Entity) SetColor(fg, bg Color) {
 
-	entity.colors = []tcell.Color{fg, bg}
+	entity.style = entity.style.Foreground(fg).Background(bg)
 	entity.scene.redraw = true
 
 }
 
+// SetStyle sets the Style the Entity is drawn with.
+// Anything that is not set on style is inherited
+// from the scene
+func (entity *Entity) SetStyle(style Style) {
+
+	entity.style = style
+
+	if nil != entity.scene {
+		entity.scene.redraw = true
+	}
+
+}
+
+// GetStyle gets the Style the Entity is drawn with
+func (entity *Entity) GetStyle() Style {
+	return entity.style
+}
+
 // Overlaps checks if the entity overlaps the target
-// entity
+// entity, using the size of each
 func (entity *Entity) Overlaps(target IEntity) bool {
-	return entity.x == target.GetEntity().x && entity.y == target.GetEntity().y
+
+	other := target.GetEntity()
+
+	return entity.spansX(other.x, other.x+other.width()) && entity.spansY(other.y, other.y+other.height())
+
 }
 
 // OverlapsPoint checks if the entity overlaps the
 // specified screen point
 func (entity *Entity) OverlapsPoint(x, y int) bool {
-	return entity.x == x && entity.y == y
+	return entity.spansX(x, x+1) && entity.spansY(y, y+1)
+}
+
+// spansX checks if the columns covered by the entity
+// overlap the columns from start up to end
+func (entity *Entity) spansX(start, end int) bool {
+	return entity.x < end && start < entity.x+entity.width()
+}
+
+// spansY checks if the rows covered by the entity
+// overlap the rows from start up to end
+func (entity *Entity) spansY(start, end int) bool {
+	return entity.y < end && start < entity.y+entity.height()
+}
+
+// width returns the number of columns the entity covers
+func (entity *Entity) width() int {
+
+	width, _ := entity.GetSize()
+
+	return width
+
+}
+
+// height returns the number of rows the entity covers
+func (entity *Entity) height() int {
+
+	_, height := entity.GetSize()
+
+	return height
+
 }
 
 // CheckDir checks if the entity is the specified
@@ -217,23 +443,39 @@ func (entity *Entity) CheckDir(axis rune, distance, point int) bool {
 // IsLeftOf checks if the entity is directly to the
 // left of the target entity
 func (entity *Entity) IsLeftOf(target IEntity) bool {
-	return entity.y == target.GetEntity().y && entity.CheckDir('x', 1, target.GetEntity().x)
+
+	other := target.GetEntity()
+
+	return entity.spansY(other.y, other.y+other.height()) && entity.CheckDir('x', entity.width(), other.x)
+
 }
 
 // IsRightOf checks if the entity is directly to the
 // right of the target entity
 func (entity *Entity) IsRightOf(target IEntity) bool {
-	return entity.y == target.GetEntity().y && entity.CheckDir('x', -1, target.GetEntity().x)
+
+	other := target.GetEntity()
+
+	return entity.spansY(other.y, other.y+other.height()) && entity.CheckDir('x', -other.width(), other.x)
+
 }
 
 // IsAbove checks if the entity is directly above
 // the target entity
 func (entity *Entity) IsAbove(target IEntity) bool {
-	return entity.x == target.GetEntity().x && entity.CheckDir('y', 1, target.GetEntity().y)
+
+	other := target.GetEntity()
+
+	return entity.spansX(other.x, other.x+other.width()) && entity.CheckDir('y', entity.height(), other.y)
+
 }
 
 // IsBelow checks if the entity is directly below
 // the target entity
 func (entity *Entity) IsBelow(target IEntity) bool {
-	return entity.x == target.GetEntity().x && entity.CheckDir('y', -1, target.GetEntity().y)
+
+	other := target.GetEntity()
+
+	return entity.spansX(other.x, other.x+other.width()) && entity.CheckDir('y', -other.height(), other.y)
+
 }
@@ -1,7 +1,5 @@
 package terminus
 
-import "github.com/gdamore/tcell"
-
 // EntityGroup represents a set of entities that
 // are grouped together within a specified boundary
 type EntityGroup struct {
@@ -13,7 +11,7 @@ type EntityGroup struct {
 }
 
 // NewEntityGroup creates a new EntityGroup
-func NewEntityGroup(x, y, width, height int, entities []IEntity, colors ...tcell.Color) *EntityGroup {
+func NewEntityGroup(x, y, width, height int, entities []IEntity, colors ...Color) *EntityGroup {
 
 	eg := &EntityGroup{
 		Entity:   NewEntity(x, y),
@@ -22,7 +20,7 @@ func NewEntityGroup(x, y, width, height int, entities []IEntity, colors ...tcell
 		entities: entities,
 	}
 
-	eg.colors = colors
+	eg.style = colorsStyle(colors)
 
 	for _, e := range eg.entities {
 		e.SetEntityGroup(eg)
@@ -38,9 +36,17 @@ func (eg *EntityGroup) Init() {
 }
 
 // Update fires after the scene update on each pass
-// through the game loop, and can be overridden
+// through the game loop, and can be overridden. The
+// entities in the group are updated after the group,
+// which plays their animators
 func (eg *EntityGroup) Update(delta float64) {
+
 	eg.Entity.Update(delta) // super
+
+	for _, e := range eg.entities {
+		e.Update(delta)
+	}
+
 }
 
 // Draw fires during scene.Draw and can be overridden
@@ -48,23 +54,15 @@ func (eg *EntityGroup) Draw() {
 
 	// override Entity.Draw
 	screen := eg.Entity.game.screen
-	currentScene := eg.game.CurrentScene()
+	base := eg.sceneStyle()
 
-	var style tcell.Style
-
-	if len(eg.colors) == 2 {
-
-		style = tcell.StyleDefault.
-			Foreground(eg.colors[0]).
-			Background(eg.colors[1])
-
-	} else {
-
-		style = currentScene.style
+	x, y := eg.GetScreenPosition()
 
+	if !eg.onScreen(x, y, eg.width+1, eg.height+1) {
+		return
 	}
 
-	for _, eInterface := range eg.entities {
+	for _, eInterface := range sortEntities(eg.entities, false) {
 
 		e := eInterface.GetEntity()
 
@@ -79,8 +77,23 @@ func (eg *EntityGroup) Draw() {
 		}
 
 		// Draw the entity to the screen offset
-		// by the position of the group
-		screen.SetContent((eg.x + e.x), (eg.y + e.y), rune(e.GetSprite()), nil, style)
+		// by the position of the group, with its
+		// own style layered over the group's
+		style := eg.style.Merge(e.style)
+
+		if nil != e.multiSprite {
+
+			// cells of a Sprite outside of the
+			// group are clipped
+			e.multiSprite.draw(screen, x+e.x, y+e.y, style, base, func(col, row int) bool {
+				return e.x+col > eg.width || e.y+row > eg.height
+			})
+
+			continue
+
+		}
+
+		screen.SetContent((x + e.x), (y + e.y), rune(e.GetSprite()), e.combining, style.resolve(base))
 
 	}
 
@@ -179,6 +192,10 @@ func (eg *EntityGroup) SetEntities(entities []IEntity) {
 
 	eg.entities = entities
 
+	for _, e := range eg.entities {
+		e.SetEntityGroup(eg)
+	}
+
 	if nil != eg.scene {
 
 		for _, e := range eg.entities {
@@ -187,8 +204,8 @@ func (eg *EntityGroup) SetEntities(entities []IEntity) {
 
 		}
 
-	}
+		eg.scene.redraw = true
 
-	eg.scene.redraw = true
+	}
 
 }
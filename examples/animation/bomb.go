@@ -0,0 +1,70 @@
+package main
+
+import (
+	t "github.com/Sheep42/terminus"
+)
+
+type Bomb struct {
+	*t.Entity
+}
+
+func NewBomb(x, y int) *Bomb {
+
+	b := &Bomb{
+		t.NewEntity(x, y),
+	}
+
+	animator := b.Animator()
+
+	// the fuse burns on a loop until the bomb is set off
+	animator.Add(t.NewSpriteClip("fuse", t.AnimationLoop, 0.2,
+		t.NewSpriteFromString(`
+   *
+  (
+ ( )`),
+		t.NewSpriteFromString(`
+  +
+  (
+ ( )`),
+	))
+
+	// the explosion plays once, then the fuse is lit
+	// again by the finished callback
+	boom := t.NewSpriteClip("boom", t.AnimationOnce, 0.12,
+		t.NewSpriteFromString(`
+
+  \|/
+ -( )-`),
+		t.NewSpriteFromString(`
+ \ | /
+-- * --
+ / | \`),
+		t.NewSpriteFromString(`
+ .   .
+.  '  .
+ '   '`),
+	)
+
+	boom.OnFinish(func() {
+		animator.Play("fuse")
+	})
+
+	animator.Add(boom)
+	animator.Play("fuse")
+
+	b.SetStyle(t.Style{}.Foreground(t.Orange))
+
+	return b
+
+}
+
+func (b *Bomb) Update(delta float64) {
+
+	// super, which plays the Animator
+	b.Entity.Update(delta)
+
+	if input := b.GetGame().Input(); nil != input && input.IsKey(t.KeyEnter) {
+		b.Animator().Play("boom")
+	}
+
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"log"
+
+	t "github.com/Sheep42/terminus"
+)
+
+func main() {
+
+	// Create the Game
+	g := t.NewGame()
+
+	// Create the Scene
+	s := t.NewScene(g)
+
+	s.Add(t.NewText(2, 1, "Press Enter to blow up the bomb, ESC to quit"))
+
+	// A spinner which loops through runes
+	spinner := t.NewEntity(4, 4)
+	spinner.Animator().Add(t.NewRuneClip("spin", t.AnimationLoop, 0.1, '|', '/', '-', '\\'))
+	spinner.Animator().Play("spin")
+	s.Add(spinner)
+
+	// A pulse which plays forwards then backwards,
+	// holding on its brightest frame for longer
+	pulse := t.NewEntity(8, 4)
+	pulse.Animator().Add(t.NewClip("pulse", t.AnimationPingPong,
+		t.AnimationFrame{Rune: '.', Duration: 0.15},
+		t.AnimationFrame{Rune: 'o', Duration: 0.15},
+		t.AnimationFrame{Rune: 'O', Duration: 0.15},
+		t.AnimationFrame{Rune: '@', Duration: 0.6},
+	))
+	pulse.Animator().Play("pulse")
+	s.Add(pulse)
+
+	// A bomb with multi-cell frames
+	s.Add(NewBomb(20, 6))
+
+	// g.Init takes a slice of IScenes
+	ss := []t.IScene{s}
+
+	// Init the Game
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
+
+	// Start the Game
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
+
+}
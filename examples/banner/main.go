@@ -0,0 +1,63 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	t "github.com/Sheep42/terminus"
+)
+
+func main() {
+
+	// Create the Game
+	g := t.NewGame()
+
+	// Create the Scene
+	s := t.NewSceneCustom(g, t.Black, t.Gray)
+
+	s.Add(t.NewText(2, 1, "Press ESC to quit", t.White, t.Black))
+
+	// Draw a title with a built in font, colored
+	// along a gradient
+	title := t.NewBannerText(2, 3, "Terminus", t.FontBlock)
+	title.SetGradient(t.NewGradient(t.ColorHex("#ff0000"), t.ColorHex("#ffff00"), t.ColorHSL(200, 1, 0.5)))
+	s.Add(title)
+
+	// Color each letter with markup tags
+	s.Add(t.NewRichBannerText(2, 10, "[red]Game [yellow]Over", t.FontMini))
+
+	// Load any FIGlet font, such as one installed
+	// with figlet, by passing its path:
+	//
+	//	go run . /usr/share/figlet/standard.flf
+	if len(os.Args) > 1 {
+
+		font, err := t.LoadFont(os.Args[1])
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Box and alignment work as they do for Text
+		custom := t.NewBannerText(2, 15, "Hello", font, t.White)
+		custom.SetBox(60, 0)
+		custom.SetAlign(t.AlignCenter)
+		custom.SetFontLayout(t.LayoutSmushing)
+		s.Add(custom)
+
+	}
+
+	// g.Init takes a slice of IScenes
+	ss := []t.IScene{s}
+
+	// Init the Game
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
+
+	// Start the Game
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
+
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	t "github.com/Sheep42/terminus"
+)
+
+// The size of the world, which is larger
+// than most terminals
+const (
+	worldWidth  = 160
+	worldHeight = 60
+)
+
+type CustomScene struct {
+	*t.Scene
+	player *Explorer
+}
+
+func NewCustomScene(g *t.Game, fg, bg t.Color) *CustomScene {
+
+	cs := &CustomScene{
+		Scene: t.NewSceneCustom(g, fg, bg),
+	}
+
+	return cs
+
+}
+
+func (cs *CustomScene) Setup() {
+
+	cs.Scene.Setup() // super
+
+	// Walls around the edge of the world
+	for x := 0; x < worldWidth; x++ {
+
+		cs.Add(t.NewSpriteEntity(x, 0, '#'))
+		cs.Add(t.NewSpriteEntity(x, worldHeight-1, '#'))
+
+	}
+
+	for y := 1; y < worldHeight-1; y++ {
+
+		cs.Add(t.NewSpriteEntity(0, y, '#'))
+		cs.Add(t.NewSpriteEntity(worldWidth-1, y, '#'))
+
+	}
+
+	// Scatter some trees around the world, so
+	// that there is something to scroll past
+	for x := 7; x < worldWidth-1; x += 13 {
+
+		for y := 5; y < worldHeight-1; y += 7 {
+
+			cs.Add(t.NewSpriteEntity(x, y+(x%3), '*', t.Green, t.Black))
+
+		}
+
+	}
+
+	cs.player = NewExplorer(worldWidth/2, worldHeight/2, '@')
+	cs.Add(cs.player)
+
+	// The HUD is positioned on the screen, so it
+	// stays put while the camera moves, and it is
+	// drawn on top of the world
+	hud := t.NewText(0, 0, "Arrow keys to explore, ESC to quit", t.White, t.Black)
+	hud.SetScreenSpace(true)
+	hud.SetZIndex(1)
+	cs.Add(hud)
+
+	// The camera follows the player once they leave
+	// the dead zone, and never shows anything beyond
+	// the edges of the world
+	camera := cs.Camera()
+	camera.SetBounds(0, 0, worldWidth, worldHeight)
+	camera.SetDeadZone(20, 8)
+	camera.SetSmoothing(8)
+	camera.Follow(cs.player)
+
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	t "github.com/Sheep42/terminus"
+)
+
+// moveDelay is the time between moves while
+// a movement key is held
+const moveDelay = 0.04
+
+type Explorer struct {
+	*t.Entity
+	repeater *t.Repeater
+}
+
+func NewExplorer(x, y int, sprite rune) *Explorer {
+
+	e := &Explorer{
+		Entity:   t.NewSpriteEntity(x, y, sprite, t.Yellow, t.Black),
+		repeater: t.NewRepeater(moveDelay),
+	}
+
+	return e
+
+}
+
+func (e *Explorer) Update(delta float64) {
+
+	// super
+	e.Entity.Update(delta)
+
+	// move one cell when a key is pressed, then every
+	// moveDelay seconds once the key repeats
+	moveX, moveY := e.repeater.Direction(e.GetGame(), delta)
+
+	if 0 == moveX && 0 == moveY {
+		return
+	}
+
+	// Positions are in world coordinates, so the
+	// explorer is kept inside of the walls of the
+	// world instead of the screen
+	x, y := e.GetX()+moveX, e.GetY()+moveY
+
+	if x > 0 && x < worldWidth-1 && y > 0 && y < worldHeight-1 {
+		e.SetPosition(x, y)
+	}
+
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"math"
+
+	t "github.com/Sheep42/terminus"
+)
+
+type CustomScene struct {
+	*t.Scene
+	angle  float64
+	health *t.Gradient
+}
+
+func NewCustomScene(g *t.Game, fg, bg t.Color) *CustomScene {
+
+	cs := &CustomScene{
+		Scene:  t.NewSceneCustom(g, fg, bg),
+		health: t.NewGradient(t.ColorHex("#d00000"), t.ColorHex("#ffaa00"), t.ColorHex("#00c000")),
+	}
+
+	return cs
+
+}
+
+func (cs *CustomScene) Update(delta float64) {
+
+	cs.Scene.Update(delta) // super
+
+	// turn the clock hand once every 4 seconds
+	cs.angle += delta * math.Pi / 2
+
+}
+
+// Draw is overridden to draw to the Scene's Canvas.
+// The Canvas is immediate mode, so everything is
+// drawn again on every frame
+func (cs *CustomScene) Draw() {
+
+	canvas := cs.Canvas()
+	w, h := canvas.Size()
+
+	// frame the whole screen
+	canvas.DrawBox(0, 0, w, h, t.BorderDouble, t.NewStyle(t.Blue, t.Black))
+	canvas.DrawText(2, 0, " Canvas ", t.Style{})
+
+	// a clock face with a turning hand. Cells are
+	// about twice as tall as they are wide, so the
+	// face is twice as wide as it is tall
+	cx, cy := w/2, h/2
+	radius := h/2 - 2
+
+	canvas.FillEllipse(cx, cy, radius*2, radius, ' ', t.NewStyle(t.White, t.DarkBlue))
+	canvas.DrawEllipse(cx, cy, radius*2, radius, 'o', t.NewStyle(t.Yellow, t.Black))
+
+	hx := cx + int(math.Round(math.Sin(cs.angle)*float64(radius*2-2)))
+	hy := cy - int(math.Round(math.Cos(cs.angle)*float64(radius-1)))
+
+	canvas.DrawLine(cx, cy, hx, hy, '*', t.NewStyle(t.Orange, t.DarkBlue))
+
+	// a few boxes in the corners
+	canvas.DrawBox(2, 2, 12, 5, t.BorderSingle, t.Style{})
+	canvas.DrawText(4, 4, "single", t.Style{})
+
+	canvas.DrawBox(w-14, 2, 12, 5, t.BorderRounded, t.NewStyle(t.Green, t.Black))
+	canvas.DrawText(w-12, 4, "rounded", t.Style{})
+
+	// a health bar which blends from red to green
+	canvas.DrawText(2, 8, "health", t.Style{})
+	canvas.FillGradient(2, 9, 12, 1, ' ', t.Style{}, cs.health, t.GradientHorizontal)
+
+	canvas.FillRect(2, h-4, w-4, 2, '░', t.NewStyle(t.Gray, t.Black))
+	canvas.DrawText(4, h-4, "Press ESC to quit", t.Style{})
+
+	// super
+	cs.Scene.Draw()
+
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"log"
+
+	t "github.com/Sheep42/terminus"
+)
+
+func main() {
+
+	// Create the Game
+	g := t.NewGame()
+
+	// Create the Scene
+	s := NewCustomScene(g, t.White, t.Black)
+
+	// g.Init takes a slice of IScenes
+	ss := []t.IScene{s}
+
+	// Init the Game
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
+
+	// Start the Game
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
+
+}
@@ -2,8 +2,6 @@ package main
 
 import (
 	t "github.com/Sheep42/terminus"
-
-	"github.com/gdamore/tcell"
 )
 
 type CustomScene struct {
@@ -12,7 +10,7 @@ type CustomScene struct {
 	player      *Moveable
 }
 
-func NewCustomScene(g *t.Game, fg, bg tcell.Color) *CustomScene {
+func NewCustomScene(g *t.Game, fg, bg t.Color) *CustomScene {
 
 	cs := &CustomScene{
 		// NewSceneCustom is like NewScene, but allows
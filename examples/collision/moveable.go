@@ -4,15 +4,21 @@ import (
 	t "github.com/Sheep42/terminus"
 )
 
+// moveDelay is the time between moves while
+// a movement key is held
+const moveDelay = 0.05
+
 type Moveable struct {
 	*t.Entity
 	collidables []t.IEntity
+	repeater    *t.Repeater
 }
 
 func NewMoveable(x, y int, sprite rune) *Moveable {
 
 	m := &Moveable{
-		Entity: t.NewSpriteEntity(x, y, sprite),
+		Entity:   t.NewSpriteEntity(x, y, sprite),
+		repeater: t.NewRepeater(moveDelay),
 	}
 
 	return m
@@ -24,7 +30,6 @@ func (m *Moveable) Update(delta float64) {
 	m.Entity.Update(delta)
 
 	game := m.GetGame()
-	input := game.Input()
 
 	// Screen Wrap
 	gw, gh := game.ScreenSize()
@@ -41,48 +46,34 @@ func (m *Moveable) Update(delta float64) {
 		m.SetPosition(m.GetX(), gh-1)
 	}
 
-	// Moveable movement with collision
-	if nil != input {
-
-		moveX, moveY := 0, 0
-
-		if t.KeyLeft == input.Key() {
-
-			moveX = -1
-
-		} else if t.KeyRight == input.Key() {
-
-			moveX = 1
-
-		} else if t.KeyUp == input.Key() {
-
-			moveY = -1
+	// Moveable movement with collision. Terminals don't
+	// report key releases, so the Repeater moves one cell
+	// when a key is pressed, then every moveDelay seconds
+	// once the terminal starts repeating it. This allows
+	// smooth movement while a key is held
+	moveX, moveY := m.repeater.Direction(game, delta)
 
-		} else if t.KeyDown == input.Key() {
-
-			moveY = 1
-
-		}
-
-		collided := false
+	if 0 == moveX && 0 == moveY {
+		return
+	}
 
-		for _, c := range m.collidables {
+	collided := false
 
-			ce := c.GetEntity()
-			ceX, ceY := ce.GetPosition()
+	for _, c := range m.collidables {
 
-			if m.CheckDir('x', moveX, ceX) && m.CheckDir('y', moveY, ceY) {
-				collided = true
-				break
-			}
+		ce := c.GetEntity()
+		ceX, ceY := ce.GetPosition()
 
+		if m.CheckDir('x', moveX, ceX) && m.CheckDir('y', moveY, ceY) {
+			collided = true
+			break
 		}
 
-		if false == collided {
+	}
 
-			m.SetPosition(m.GetX()+moveX, m.GetY()+moveY)
+	if false == collided {
 
-		}
+		m.SetPosition(m.GetX()+moveX, m.GetY()+moveY)
 
 	}
 
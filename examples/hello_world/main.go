@@ -1,6 +1,8 @@
 package main
 
 import (
+	"log"
+
 	t "github.com/Sheep42/terminus"
 )
 
@@ -24,9 +26,13 @@ func main() {
 	ss := []t.IScene{s}
 
 	// Init the Game
-	g.Init(ss)
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
 
 	// Start the Game
-	g.Start()
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
 
 }
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"log"
+
 	t "github.com/Sheep42/terminus"
 )
 
@@ -19,7 +21,9 @@ func main() {
 	ss := []t.IScene{s}
 
 	// Init the Game
-	g.Init(ss)
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
 
 	l := g.GetLogger()
 
@@ -29,6 +33,8 @@ func main() {
 	l.Fatalf("Or you can crash the game and print a formatted error like this: %s Code: %d", "Oh no a spooky error occurred!", 12345)
 
 	// Start the Game
-	g.Start()
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
 
 }
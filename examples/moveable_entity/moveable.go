@@ -23,7 +23,6 @@ func (m *Moveable) Update(delta float64) {
 	m.Entity.Update(delta)
 
 	game := m.GetGame()
-	input := game.Input()
 
 	// Screen Wrap
 	gw, gh := game.ScreenSize()
@@ -40,26 +39,23 @@ func (m *Moveable) Update(delta float64) {
 		m.SetPosition(m.GetX(), gh-1)
 	}
 
-	// Moveable movement
-	if nil != input {
+	// Moveable movement - actions are bound to keys
+	// by the Game's InputMap
+	if game.ActionPressed(t.ActionMoveLeft) {
 
-		if t.KeyLeft == input.Key() {
+		m.SetPosition(m.GetX()-1, m.GetY())
 
-			m.SetPosition(m.GetX()-1, m.GetY())
+	} else if game.ActionPressed(t.ActionMoveRight) {
 
-		} else if t.KeyRight == input.Key() {
+		m.SetPosition(m.GetX()+1, m.GetY())
 
-			m.SetPosition(m.GetX()+1, m.GetY())
+	} else if game.ActionPressed(t.ActionMoveUp) {
 
-		} else if t.KeyUp == input.Key() {
+		m.SetPosition(m.GetX(), m.GetY()-1)
 
-			m.SetPosition(m.GetX(), m.GetY()-1)
+	} else if game.ActionPressed(t.ActionMoveDown) {
 
-		} else if t.KeyDown == input.Key() {
-
-			m.SetPosition(m.GetX(), m.GetY()+1)
-
-		}
+		m.SetPosition(m.GetX(), m.GetY()+1)
 
 	}
 
@@ -2,8 +2,6 @@ package main
 
 import (
 	t "github.com/Sheep42/terminus"
-
-	"github.com/gdamore/tcell"
 )
 
 type CustomScene struct {
@@ -11,7 +9,7 @@ type CustomScene struct {
 	title *t.Text
 }
 
-func NewCustomScene(g *t.Game, fg, bg tcell.Color, title string) *CustomScene {
+func NewCustomScene(g *t.Game, fg, bg t.Color, title string) *CustomScene {
 
 	cs := &CustomScene{
 		// NewSceneCustom is like NewScene, but allows
@@ -37,8 +35,20 @@ func (cs *CustomScene) Init() {
 	cs.Scene.Init()
 
 	game := cs.Game()
+	cs.centerTitle(game.ScreenSize())
+
+}
+
+// OnResize keeps the title centered when the
+// terminal is resized
+func (cs *CustomScene) OnResize(screenWidth, screenHeight int) {
+
+	cs.centerTitle(screenWidth, screenHeight)
+
+}
+
+func (cs *CustomScene) centerTitle(screenWidth, screenHeight int) {
 
-	screenWidth, screenHeight := game.ScreenSize()
 	textWidth, textHeight := cs.title.GetDimensions()
 
 	cs.title.SetPosition(screenWidth/2-textWidth/2, screenHeight/2-textHeight/2)
@@ -53,11 +63,11 @@ func (cs *CustomScene) Update(delta float64) {
 
 	if nil != input {
 
-		if 'z' == input.Rune() {
+		if input.IsRune('z') {
 
 			game.PrevScene()
 
-		} else if 'x' == input.Rune() {
+		} else if input.IsRune('x') {
 
 			game.NextScene()
 
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"log"
+
 	t "github.com/Sheep42/terminus"
 )
 
@@ -12,13 +14,21 @@ func main() {
 	// Create the Scene
 	s := NewCustomScene(g, t.DarkGreen, t.Black)
 
+	// Update the game logic 30 times per second, so the
+	// snake moves at the same speed regardless of FPS
+	g.SetTickRate(30)
+
 	// g.Init takes a slice of IScenes
 	ss := []t.IScene{s}
 
 	// Init the Game
-	g.Init(ss)
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
 
 	// Start the Game
-	g.Start()
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
 
 }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log"
+
+	t "github.com/Sheep42/terminus"
+)
+
+func main() {
+
+	// Create the Game
+	g := t.NewGame()
+
+	// Create the Scene
+	s := t.NewScene(g)
+
+	// Sprites can be loaded from text files. Run
+	// the example from its own directory so that
+	// the file can be found
+	art, err := t.LoadSprite("asteroid.txt")
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	asteroid := t.NewMultiSpriteEntity(30, 8, art)
+	asteroid.SetStyle(t.NewStyle(t.Gray, t.Black))
+	s.Add(asteroid)
+
+	s.Add(t.NewText(2, 1, "Arrow keys to fly, ESC to quit"))
+
+	// The ship is drawn on top of the asteroid
+	ship := NewShip(6, 10, asteroid)
+	ship.SetZIndex(1)
+	s.Add(ship)
+
+	// g.Init takes a slice of IScenes
+	ss := []t.IScene{s}
+
+	// Init the Game
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
+
+	// Start the Game
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
+
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	t "github.com/Sheep42/terminus"
+)
+
+type Ship struct {
+	*t.Entity
+	asteroid *t.Entity
+}
+
+func NewShip(x, y int, asteroid *t.Entity) *Ship {
+
+	// Spaces in the art are transparent, so the
+	// background shows through around the ship
+	sprite := t.NewSpriteFromString(`
+  /\
+ /  \
+|_[]_|
+ ^  ^`)
+
+	// Each rune of the mask picks the color of
+	// the cell in the same place
+	sprite.SetColorMask(`
+  ww
+ wwww
+wwbbww
+ rr rr`, map[rune]t.Style{
+		'w': t.Style{}.Foreground(t.White),
+		'b': t.Style{}.Foreground(t.LightBlue).Bold(true),
+		'r': t.Style{}.Foreground(t.Orange),
+	})
+
+	s := &Ship{
+		t.NewMultiSpriteEntity(x, y, sprite),
+		asteroid,
+	}
+
+	return s
+
+}
+
+func (s *Ship) Update(delta float64) {
+
+	// super
+	s.Entity.Update(delta)
+
+	game := s.GetGame()
+	x, y := s.GetPosition()
+
+	if game.ActionPressed(t.ActionMoveLeft) {
+		x--
+	} else if game.ActionPressed(t.ActionMoveRight) {
+		x++
+	} else if game.ActionPressed(t.ActionMoveUp) {
+		y--
+	} else if game.ActionPressed(t.ActionMoveDown) {
+		y++
+	}
+
+	if x != s.GetX() || y != s.GetY() {
+		s.SetPosition(x, y)
+	}
+
+	// Collision uses the size of each Sprite, so
+	// the asteroid turns red while the ship is
+	// anywhere over it
+	if s.Overlaps(s.asteroid) {
+		s.asteroid.SetColor(t.Red, t.Black)
+	} else {
+		s.asteroid.SetColor(t.Gray, t.Black)
+	}
+
+}
@@ -47,7 +47,7 @@ func (ps *PauseState) Tick(delta float64) {
 
 	if nil != i {
 
-		if 'p' == i.Rune() {
+		if i.IsRune('p') {
 
 			// Change to RunState
 			ps.cs.stateManager.ChangeState(ps.cs.runState)
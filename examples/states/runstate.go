@@ -31,7 +31,7 @@ func (rs *RunState) Tick(delta float64) {
 
 	if nil != i {
 
-		if 'p' == i.Rune() {
+		if i.IsRune('p') {
 
 			// change to the PauseState
 			rs.cs.stateManager.ChangeState(rs.cs.pauseState)
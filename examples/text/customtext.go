@@ -2,18 +2,16 @@ package main
 
 import (
 	t "github.com/Sheep42/terminus"
-
-	"github.com/gdamore/tcell"
 )
 
 type CustomText struct {
 	*t.Text
 	elapsed    float64
-	colors     [][]tcell.Color
+	colors     [][]t.Color
 	colorIndex int
 }
 
-func NewCustomText(x, y int, text string, colors [][]tcell.Color) *CustomText {
+func NewCustomText(x, y int, text string, colors [][]t.Color) *CustomText {
 
 	return &CustomText{
 		t.NewText(x, y, text, colors[0][0], colors[0][1]),
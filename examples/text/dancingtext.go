@@ -2,8 +2,6 @@ package main
 
 import (
 	t "github.com/Sheep42/terminus"
-
-	"github.com/gdamore/tcell"
 )
 
 type DancingText struct {
@@ -12,7 +10,7 @@ type DancingText struct {
 	mod     int
 }
 
-func NewDancingText(x, y int, text string, colors ...tcell.Color) *DancingText {
+func NewDancingText(x, y int, text string, colors ...t.Color) *DancingText {
 
 	dt := &DancingText{
 		t.NewText(x, y, text),
@@ -1,9 +1,9 @@
 package main
 
 import (
-	t "github.com/Sheep42/terminus"
+	"log"
 
-	"github.com/gdamore/tcell"
+	t "github.com/Sheep42/terminus"
 )
 
 func main() {
@@ -20,8 +20,30 @@ func main() {
 	// Inherit scene color
 	s.Add(t.NewText(5, 5, "Hello World"))
 
+	// Wide characters, accents and emoji each take
+	// up the right number of cells
+	s.Add(t.NewText(5, 7, "Wide text: 日本語 café 👍🏽"))
+
+	// Lay text out in a box, wrapping long lines
+	// between words and centering each line
+	help := t.NewText(40, 2, "This text is laid out in a box 24 cells wide. Long lines are wrapped between words, and each line is centered.\nA newline starts a new paragraph, and anything that does not fit ends with an ellipsis.")
+	help.SetBox(24, 7)
+	help.SetWrap(t.WrapWord)
+	help.SetAlign(t.AlignCenter)
+	help.SetOverflow(t.OverflowEllipsis)
+	s.Add(help)
+
+	// Mix colors and attributes in one string with
+	// markup tags
+	s.Add(t.NewRichText(5, 9, "Score: [yellow]1200[-]  Lives: [red]♥♥♥[-]  [b]Level 3[/b]  [#ff8800:black] HOT [-:-]"))
+
+	// Color each character along a gradient
+	rainbow := t.NewText(30, 5, "Gradient Text")
+	rainbow.SetGradient(t.NewGradient(t.ColorHex("#ff0000"), t.ColorHex("#ffff00"), t.ColorHSL(200, 1, 0.5)))
+	s.Add(rainbow)
+
 	// Extend text functionality using composition
-	s.Add(NewCustomText(10, 10, "Color Changing", [][]tcell.Color{
+	s.Add(NewCustomText(10, 10, "Color Changing", [][]t.Color{
 		{t.DarkBlue, t.Green},
 		{t.Black, t.Gray},
 		{t.DarkRed, t.White},
@@ -35,9 +57,13 @@ func main() {
 	ss := []t.IScene{s}
 
 	// Init the Game
-	g.Init(ss)
+	if err := g.Init(ss); err != nil {
+		log.Fatal(err)
+	}
 
 	// Start the Game
-	g.Start()
+	if err := g.Start(); err != nil {
+		log.Fatal(err)
+	}
 
 }
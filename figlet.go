@@ -0,0 +1,590 @@
+package terminus
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//go:embed fonts/*.flf
+var builtinFonts embed.FS
+
+// Built in fonts. Lowercase letters are drawn as
+// capitals
+var (
+	// FontBlock is 5 rows of solid blocks
+	FontBlock = builtinFont("fonts/block.flf")
+
+	// FontMini is 3 rows of half blocks
+	FontMini = builtinFont("fonts/mini.flf")
+)
+
+// FontLayout is how the characters of a Font are
+// fitted together
+type FontLayout int
+
+// Font Layouts
+const (
+	// LayoutDefault uses the layout set by the Font
+	LayoutDefault FontLayout = iota
+
+	// LayoutFull draws each character at its full width
+	LayoutFull
+
+	// LayoutKerning moves characters together until
+	// they touch
+	LayoutKerning
+
+	// LayoutSmushing moves characters together until
+	// they overlap by one column, merging the
+	// overlapping characters with the smushing rules
+	// of the Font
+	LayoutSmushing
+)
+
+// smushing rules, as stored in FIGlet font headers
+const (
+	smushEqual     = 1
+	smushLowLine   = 2
+	smushHierarchy = 4
+	smushPair      = 8
+	smushBigX      = 16
+	smushHardblank = 32
+	smushKern      = 64
+	smushSmush     = 128
+	smushRules     = 63
+)
+
+// germanChars are the characters which follow the
+// ASCII characters in a FIGlet font
+var germanChars = []rune{'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', 'ß'}
+
+// Font is a FIGlet font, which draws each character
+// as a block of text several rows high
+type Font struct {
+	hardblank rune
+	height    int
+	baseline  int
+	smush     int
+	comment   string
+	chars     map[rune][][]rune
+}
+
+// ParseFont creates a Font from the contents of a
+// FIGlet .flf font file
+func ParseFont(data string) (*Font, error) {
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("terminus: error parsing font: no header")
+	}
+
+	header := strings.Fields(scanner.Text())
+
+	if len(header) < 6 || !strings.HasPrefix(header[0], "flf2a") || len([]rune(header[0])) < 6 {
+		return nil, fmt.Errorf("terminus: error parsing font: invalid header")
+	}
+
+	values := make([]int, len(header)-1)
+
+	for i, field := range header[1:] {
+
+		value, err := strconv.Atoi(field)
+
+		if nil != err {
+			return nil, fmt.Errorf("terminus: error parsing font header: %w", err)
+		}
+
+		values[i] = value
+
+	}
+
+	if values[0] < 1 {
+		return nil, fmt.Errorf("terminus: error parsing font: invalid height %d", values[0])
+	}
+
+	font := &Font{
+		hardblank: []rune(header[0])[5],
+		height:    values[0],
+		baseline:  values[1],
+		chars:     map[rune][][]rune{},
+	}
+
+	// the full layout replaces the old layout if
+	// it is given
+	switch oldLayout := values[3]; {
+	case len(values) > 6:
+		font.smush = values[6]
+	case 0 == oldLayout:
+		font.smush = smushKern
+	case oldLayout > 0:
+		font.smush = (oldLayout & 31) | smushSmush
+	}
+
+	comment := []string{}
+
+	for i := 0; i < values[4] && scanner.Scan(); i++ {
+		comment = append(comment, scanner.Text())
+	}
+
+	font.comment = strings.Join(comment, "\n")
+
+	readChar := func() ([][]rune, bool) {
+
+		rows := make([][]rune, font.height)
+		width := 0
+
+		for row := range rows {
+
+			if !scanner.Scan() {
+				return nil, false
+			}
+
+			rows[row] = fontRow(scanner.Text())
+
+			if len(rows[row]) > width {
+				width = len(rows[row])
+			}
+
+		}
+
+		// every row is the same width
+		for row := range rows {
+
+			for len(rows[row]) < width {
+				rows[row] = append(rows[row], ' ')
+			}
+
+		}
+
+		return rows, true
+
+	}
+
+	for code := ' '; code <= '~'; code++ {
+
+		rows, ok := readChar()
+
+		if !ok {
+			return nil, fmt.Errorf("terminus: error parsing font: missing character %q", code)
+		}
+
+		font.chars[code] = rows
+
+	}
+
+	for _, code := range germanChars {
+
+		rows, ok := readChar()
+
+		if !ok {
+			return font, nil
+		}
+
+		font.chars[code] = rows
+
+	}
+
+	// the rest of the characters are each tagged
+	// with their code
+	for scanner.Scan() {
+
+		tag := strings.Fields(scanner.Text())
+
+		if 0 == len(tag) {
+			continue
+		}
+
+		code, err := strconv.ParseInt(tag[0], 0, 32)
+
+		if nil != err {
+			return nil, fmt.Errorf("terminus: error parsing font code tag: %w", err)
+		}
+
+		rows, ok := readChar()
+
+		if !ok {
+			return nil, fmt.Errorf("terminus: error parsing font: missing character %q", rune(code))
+		}
+
+		if code >= 0 {
+			font.chars[rune(code)] = rows
+		}
+
+	}
+
+	if err := scanner.Err(); nil != err {
+		return nil, fmt.Errorf("terminus: error parsing font: %w", err)
+	}
+
+	return font, nil
+
+}
+
+// LoadFont creates a Font from the FIGlet .flf font
+// file at path
+func LoadFont(path string) (*Font, error) {
+
+	data, err := os.ReadFile(path)
+
+	if nil != err {
+		return nil, fmt.Errorf("terminus: error loading font: %w", err)
+	}
+
+	return ParseFont(string(data))
+
+}
+
+// builtinFont parses one of the fonts embedded in
+// the package
+func builtinFont(path string) *Font {
+
+	data, err := builtinFonts.ReadFile(path)
+
+	if nil != err {
+		panic(err)
+	}
+
+	font, err := ParseFont(string(data))
+
+	if nil != err {
+		panic(err)
+	}
+
+	return font
+
+}
+
+// fontRow returns a row of a FIGlet character without
+// its end marks, which are the last character of the
+// line and any copies of it before that
+func fontRow(line string) []rune {
+
+	row := []rune(strings.TrimRightFunc(line, unicode.IsSpace))
+
+	if 0 == len(row) {
+		return row
+	}
+
+	end := row[len(row)-1]
+
+	for len(row) > 0 && end == row[len(row)-1] {
+		row = row[:len(row)-1]
+	}
+
+	return row
+
+}
+
+// GetHeight gets the number of rows of each character
+func (font *Font) GetHeight() int {
+	return font.height
+}
+
+// GetBaseline gets the number of rows from the top of
+// a character to the baseline of the Font
+func (font *Font) GetBaseline() int {
+	return font.baseline
+}
+
+// GetComment gets the comment of the font file, which
+// usually has its author and license
+func (font *Font) GetComment() string {
+	return font.comment
+}
+
+// HasChar checks if the Font can draw r
+func (font *Font) HasChar(r rune) bool {
+
+	_, ok := font.chars[r]
+
+	return ok
+
+}
+
+// Render returns text drawn with the Font, one string
+// per row. A newline in text starts a new banner below
+// the one before it. Characters that are not in the
+// Font are skipped
+func (font *Font) Render(text string, layout FontLayout) []string {
+
+	rows := []string{}
+
+	for _, row := range font.render(graphemes(text), layout) {
+		rows = append(rows, graphemesText(row))
+	}
+
+	return rows
+
+}
+
+// mode returns the smushing rules used for layout
+func (font *Font) mode(layout FontLayout) int {
+
+	switch layout {
+	case LayoutFull:
+		return 0
+	case LayoutKerning:
+		return smushKern
+	case LayoutSmushing:
+		return font.smush&smushRules | smushSmush
+	}
+
+	return font.smush
+
+}
+
+// render draws chars with the Font. Each cell of the
+// result has the Style of the character it came from
+func (font *Font) render(chars []grapheme, layout FontLayout) [][]grapheme {
+
+	mode := font.mode(layout)
+	result := [][]grapheme{}
+	rows := font.emptyRows()
+	previous := 0
+
+	for i := 0; i <= len(chars); i++ {
+
+		if i == len(chars) || '\n' == chars[i].r {
+
+			result = append(result, font.blank(rows)...)
+			rows = font.emptyRows()
+			previous = 0
+
+			continue
+
+		}
+
+		glyph, ok := font.chars[chars[i].r]
+
+		if !ok {
+			continue
+		}
+
+		width := len(glyph[0])
+		amount := font.smushAmount(rows, glyph, mode, previous)
+
+		for row := range rows {
+
+			line := rows[row]
+			start := len(line) - amount
+
+			for col, r := range glyph[row] {
+
+				cell := grapheme{r: r, width: runeWidth(r), style: chars[i].style}
+
+				switch at := start + col; {
+				case at < 0:
+					// only blank columns are moved past the
+					// start of the row
+				case at < len(line):
+
+					merged := font.smushChars(line[at].r, r, mode, previous, width)
+
+					// the left character keeps its Style, and
+					// anything else takes the Style of the right
+					if 0 != merged && merged != line[at].r {
+						cell.r, cell.width = merged, runeWidth(merged)
+						line[at] = cell
+					}
+
+				default:
+					line = append(line, cell)
+				}
+
+			}
+
+			rows[row] = line
+
+		}
+
+		previous = width
+
+	}
+
+	return result
+
+}
+
+// emptyRows returns a row for each row of the Font
+func (font *Font) emptyRows() [][]grapheme {
+	return make([][]grapheme, font.height)
+}
+
+// blank replaces the hardblanks of rows with spaces
+func (font *Font) blank(rows [][]grapheme) [][]grapheme {
+
+	for _, line := range rows {
+
+		for col := range line {
+
+			if font.hardblank == line[col].r {
+				line[col].r = ' '
+			}
+
+		}
+
+	}
+
+	return rows
+
+}
+
+// smushAmount returns the number of columns that glyph
+// can be moved left into rows
+func (font *Font) smushAmount(rows [][]grapheme, glyph [][]rune, mode int, previous int) int {
+
+	if 0 == mode&(smushKern|smushSmush) {
+		return 0
+	}
+
+	width := len(glyph[0])
+	amount := width
+
+	for row, line := range rows {
+
+		lineEnd := len(line) - 1
+
+		for lineEnd >= 0 && ' ' == line[lineEnd].r {
+			lineEnd--
+		}
+
+		charStart := 0
+
+		for charStart < width && ' ' == glyph[row][charStart] {
+			charStart++
+		}
+
+		n := charStart + len(line) - 1 - lineEnd
+
+		switch {
+		case lineEnd < 0:
+			n = charStart + len(line)
+		case charStart < width && 0 != font.smushChars(line[lineEnd].r, glyph[row][charStart], mode, previous, width):
+			n++
+		}
+
+		if n < amount {
+			amount = n
+		}
+
+	}
+
+	return amount
+
+}
+
+// smushChars returns the character left and right are
+// merged into, or 0 if they cannot be merged
+func (font *Font) smushChars(left, right rune, mode int, leftWidth, rightWidth int) rune {
+
+	if ' ' == left {
+		return right
+	}
+
+	if ' ' == right {
+		return left
+	}
+
+	if leftWidth < 2 || rightWidth < 2 || 0 == mode&smushSmush {
+		return 0
+	}
+
+	// universal smushing keeps the right character
+	if 0 == mode&smushRules {
+
+		if font.hardblank == left {
+			return right
+		}
+
+		if font.hardblank == right {
+			return left
+		}
+
+		return right
+
+	}
+
+	if font.hardblank == left || font.hardblank == right {
+
+		if 0 != mode&smushHardblank && left == right {
+			return left
+		}
+
+		return 0
+
+	}
+
+	if 0 != mode&smushEqual && left == right {
+		return left
+	}
+
+	if 0 != mode&smushLowLine {
+
+		if '_' == left && strings.ContainsRune(`|/\[]{}()<>`, right) {
+			return right
+		}
+
+		if '_' == right && strings.ContainsRune(`|/\[]{}()<>`, left) {
+			return left
+		}
+
+	}
+
+	if 0 != mode&smushHierarchy {
+
+		classes := []string{"|", `/\`, "[]", "{}", "()", "<>"}
+		leftClass, rightClass := -1, -1
+
+		for i, class := range classes {
+
+			if strings.ContainsRune(class, left) {
+				leftClass = i
+			}
+
+			if strings.ContainsRune(class, right) {
+				rightClass = i
+			}
+
+		}
+
+		if leftClass >= 0 && rightClass >= 0 && leftClass != rightClass {
+
+			if leftClass > rightClass {
+				return left
+			}
+
+			return right
+
+		}
+
+	}
+
+	pair := string([]rune{left, right})
+
+	if 0 != mode&smushPair && strings.Contains("[] ][ {} }{ () )(", pair) {
+		return '|'
+	}
+
+	if 0 != mode&smushBigX {
+
+		switch pair {
+		case `/\`:
+			return '|'
+		case `\/`:
+			return 'Y'
+		case "><":
+			return 'X'
+		}
+
+	}
+
+	return 0
+
+}
@@ -0,0 +1,134 @@
+package terminus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// testFont returns a one row font in which each rune
+// of glyphs is drawn as the given text, and every
+// other character as x
+func testFont(tb testing.TB, layout int, glyphs map[rune]string) *Font {
+
+	tb.Helper()
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "flf2a$ 1 1 4 -1 0 0 %d 0\n", layout)
+
+	for code := ' '; code <= '~'; code++ {
+
+		glyph, ok := glyphs[code]
+
+		if !ok {
+			glyph = "x"
+		}
+
+		sb.WriteString(glyph + "@@\n")
+
+	}
+
+	font, err := ParseFont(sb.String())
+
+	if nil != err {
+		tb.Fatalf("ParseFont: %v", err)
+	}
+
+	return font
+
+}
+
+func TestFontSmushingRules(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		layout int
+		left   string
+		right  string
+		want   string
+	}{
+		{"equal", smushSmush | smushEqual, "a|", "|b", "a|b"},
+		{"low line left", smushSmush | smushLowLine, "a_", "/b", "a/b"},
+		{"low line right", smushSmush | smushLowLine, "a{", "_b", "a{b"},
+		{"hierarchy right", smushSmush | smushHierarchy, "a|", "/b", "a/b"},
+		{"hierarchy left", smushSmush | smushHierarchy, "a<", "[b", "a<b"},
+		{"pair brackets", smushSmush | smushPair, "a[", "]b", "a|b"},
+		{"pair parens", smushSmush | smushPair, "a)", "(b", "a|b"},
+		{"big x bar", smushSmush | smushBigX, "a/", `\b`, "a|b"},
+		{"big x y", smushSmush | smushBigX, `a\`, "/b", "aYb"},
+		{"big x x", smushSmush | smushBigX, "a>", "<b", "aXb"},
+		{"hardblank", smushSmush | smushHardblank, "a$", "$b", "a b"},
+		{"hardblank without rule", smushSmush | smushEqual, "a$", "$b", "a  b"},
+		{"universal", smushSmush, "ax", "yb", "ayb"},
+		{"universal hardblank", smushSmush, "a$", "yb", "ayb"},
+		{"no rule kerns", smushSmush | smushBigX, "a/", "/b", "a//b"},
+		{"kerning", smushKern, "a ", " b", "ab"},
+		{"full width", 0, "a|", "|b", "a||b"},
+	}
+
+	for _, test := range tests {
+
+		font := testFont(t, test.layout, map[rune]string{'L': test.left, 'R': test.right})
+		rows := font.Render("LR", LayoutDefault)
+
+		if 1 != len(rows) || test.want != rows[0] {
+			t.Errorf("%s: %q + %q = %q, want %q", test.name, test.left, test.right, rows, test.want)
+		}
+
+	}
+
+}
+
+func TestFontSmushingNarrowChars(t *testing.T) {
+
+	// characters one column wide are only kerned
+	font := testFont(t, smushSmush|smushEqual, map[rune]string{'L': "|", 'R': "|b"})
+
+	if rows := font.Render("LR", LayoutDefault); "||b" != rows[0] {
+		t.Errorf("got %q, want %q", rows[0], "||b")
+	}
+
+}
+
+func TestFontLayouts(t *testing.T) {
+
+	font := testFont(t, smushSmush|smushEqual, map[rune]string{'L': "a| ", 'R': " |b"})
+
+	tests := []struct {
+		layout FontLayout
+		want   string
+	}{
+		{LayoutDefault, "a|b"},
+		{LayoutFull, "a|  |b"},
+		{LayoutKerning, "a||b"},
+		{LayoutSmushing, "a|b"},
+	}
+
+	for _, test := range tests {
+
+		if rows := font.Render("LR", test.layout); test.want != rows[0] {
+			t.Errorf("layout %d: got %q, want %q", test.layout, rows[0], test.want)
+		}
+
+	}
+
+}
+
+func TestBannerTextSmushedStyle(t *testing.T) {
+
+	font := testFont(t, smushSmush|smushPair, map[rune]string{'L': "a[", 'R': "]b"})
+	chars := parseMarkup("[red]L[blue]R")
+	row := font.render(chars, LayoutDefault)[0]
+
+	if "a|b" != graphemesText(row) {
+		t.Fatalf("got %q, want %q", graphemesText(row), "a|b")
+	}
+
+	// the merged character takes the Style of the
+	// character on the right
+	if Blue != row[1].style.fg || Red != row[0].style.fg {
+		t.Errorf("styles = %v, %v, want red, blue", row[0].style.fg, row[1].style.fg)
+	}
+
+}
@@ -0,0 +1,185 @@
+package terminus
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// Cell represents the contents of a single
+// screen cell
+type Cell struct {
+	Rune      rune
+	Combining []rune
+	Style     tcell.Style
+}
+
+// width returns the number of cells the Cell is
+// drawn across
+func (c Cell) width() int {
+
+	if regionalIndicator(c.Rune) && len(c.Combining) > 0 {
+		return 2
+	}
+
+	return runeWidth(c.Rune)
+
+}
+
+// Frame is a snapshot of the cells rendered to
+// the game screen
+type Frame struct {
+	width  int
+	height int
+	cells  []Cell
+}
+
+// NewFrame creates an empty Frame of the given size
+func NewFrame(width, height int) *Frame {
+
+	frame := &Frame{
+		width:  width,
+		height: height,
+		cells:  make([]Cell, width*height),
+	}
+
+	for i := range frame.cells {
+		frame.cells[i].Rune = ' '
+	}
+
+	return frame
+
+}
+
+// newSimFrame creates a Frame from the contents
+// currently shown on a tcell SimulationScreen
+func newSimFrame(sim tcell.SimulationScreen) *Frame {
+
+	simCells, width, height := sim.GetContents()
+	frame := NewFrame(width, height)
+
+	for i, sc := range simCells {
+
+		if len(sc.Runes) == 0 {
+			continue
+		}
+
+		frame.cells[i] = Cell{
+			Rune:      sc.Runes[0],
+			Combining: sc.Runes[1:],
+			Style:     sc.Style,
+		}
+
+	}
+
+	return frame
+
+}
+
+// newScreenFrame creates a Frame from the cells that
+// have been drawn to screen but not shown yet
+func newScreenFrame(screen tcell.Screen) *Frame {
+
+	width, height := screen.Size()
+	frame := NewFrame(width, height)
+
+	for y := 0; y < height; y++ {
+
+		for x := 0; x < width; x++ {
+
+			mainc, combc, style, _ := screen.GetContent(x, y)
+
+			if 0 == mainc {
+				mainc = ' '
+			}
+
+			frame.cells[y*width+x] = Cell{mainc, combc, style}
+
+		}
+
+	}
+
+	return frame
+
+}
+
+// draw draws every cell of the Frame to screen
+// and shows it
+func (frame *Frame) draw(screen tcell.Screen) {
+
+	for i, c := range frame.cells {
+		screen.SetContent(i%frame.width, i/frame.width, c.Rune, c.Combining, c.Style)
+	}
+
+	screen.Show()
+	screen.Clear()
+
+}
+
+// Size returns the width and height of the Frame
+func (frame *Frame) Size() (int, int) {
+	return frame.width, frame.height
+}
+
+// Cell returns the Cell at x, y. If the point is
+// out of bounds ok returns false
+func (frame *Frame) Cell(x, y int) (Cell, bool) {
+
+	if x < 0 || x >= frame.width || y < 0 || y >= frame.height {
+		return Cell{}, false
+	}
+
+	return frame.cells[y*frame.width+x], true
+
+}
+
+// SetCell sets the Cell at x, y. Points outside of
+// the Frame are ignored
+func (frame *Frame) SetCell(x, y int, cell Cell) {
+
+	if x < 0 || x >= frame.width || y < 0 || y >= frame.height {
+		return
+	}
+
+	frame.cells[y*frame.width+x] = cell
+
+}
+
+// Row returns the text of row y, without styles
+func (frame *Frame) Row(y int) string {
+
+	if y < 0 || y >= frame.height {
+		return ""
+	}
+
+	var sb strings.Builder
+	row := frame.cells[y*frame.width : (y+1)*frame.width]
+
+	// the cells covered by a wide rune are skipped
+	for x := 0; x < len(row); x += row[x].width() {
+
+		sb.WriteRune(row[x].Rune)
+
+		for _, comb := range row[x].Combining {
+			sb.WriteRune(comb)
+		}
+
+	}
+
+	return sb.String()
+
+}
+
+// String returns the text of the Frame, one line
+// per row, without styles
+func (frame *Frame) String() string {
+
+	rows := make([]string, frame.height)
+
+	for y := range rows {
+		rows[y] = frame.Row(y)
+	}
+
+	return strings.Join(rows, "\n")
+
+}
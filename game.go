@@ -1,9 +1,13 @@
 package terminus
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gdamore/tcell"
@@ -12,36 +16,103 @@ import (
 // Game is collection of properties used to
 // abstract interaction with a tcell Screen
 type Game struct {
-	screen       tcell.Screen
-	width        int
-	height       int
-	scenes       []IScene
-	sceneIndex   int
-	exitKey      tcell.Key
-	input        *tcell.EventKey
-	chanKeyPress chan *tcell.EventKey
-	fps          float64
-	logger       *log.Logger
-	logFile      *os.File
-	logFileName  string
-	ticker       *time.Ticker
+	screen      tcell.Screen
+	width       int
+	height      int
+	scenes      []IScene
+	sceneIndex  int
+	sceneNames  map[string]IScene
+	sceneStack  []sceneLayer
+	compositing bool
+	capturing   bool
+	drawing     *Scene
+	initialized bool
+	exitKey     Key
+	input       *KeyEvent
+	inputs      []*KeyEvent
+	inputMap    *InputMap
+	tracker     *InputTracker
+	sequences   *SequenceMatcher
+
+	mouseInputs   []*MouseEvent
+	mouseX        int
+	mouseY        int
+	mouseButtons  ButtonMask
+	mouseTarget   []IEntity
+	mouseDisabled bool
+
+	events      eventQueue
+	fps         float64
+	tickRate    float64
+	accumulator float64
+	alpha       float64
+	logger      *log.Logger
+	logFile     *os.File
+	logFileName string
+	ticker      *time.Ticker
+	quit        bool
+	finished    bool
+
+	transition       *Transition
+	activeTransition *Transition
+
+	recentInputs []*KeyEvent
+	inputPanics  chan *PanicError
 }
 
 // NewGame creates a game
 func NewGame() *Game {
 
-	game := &Game{}
+	game := &Game{
+		logger: newLogger(),
+	}
+
+	return game
+
+}
+
+// NewHeadlessGame creates a game which renders to an
+// in-memory tcell SimulationScreen of the given size
+// instead of a terminal. Useful for running a game
+// under go test
+func NewHeadlessGame(width, height int) *Game {
+
+	game := &Game{
+		screen: tcell.NewSimulationScreen("UTF-8"),
+		width:  width,
+		height: height,
+		logger: newLogger(),
+	}
 
 	return game
 
 }
 
+// newLogger creates the logger of a game, which writes
+// to stderr until the log file is opened by Init
+func newLogger() *log.Logger {
+	return log.New(os.Stderr, "", log.Ldate|log.Ltime|log.LUTC|log.Lshortfile)
+}
+
 // Init takes an array of scenes, and sets up the game
-// before the loop is started
-func (game *Game) Init(scenes []IScene) {
+// before the loop is started. Scenes registered with
+// AddScene before Init follow the given scenes, and a
+// scene given more than once is only set up once. An
+// error is returned if there are no scenes, or if the log file
+// or the screen cannot be set up
+func (game *Game) Init(scenes []IScene) error {
+
+	scenes = uniqueScenes(append(append([]IScene{}, scenes...), game.scenes...))
+
+	if len(scenes) == 0 {
+		return errors.New("terminus: at least one scene is required")
+	}
 
 	game.exitKey = KeyEsc
-	game.logger = log.New(os.Stderr, "", log.Ldate|log.Ltime|log.LUTC|log.Lshortfile)
+
+	if nil == game.logger {
+		game.logger = newLogger()
+	}
 
 	if game.logFileName == "" {
 		game.logFileName = "terminus.log"
@@ -49,28 +120,35 @@ func (game *Game) Init(scenes []IScene) {
 
 	baseDir, err := filepath.Abs(filepath.Dir(os.Args[0])) // baseDir = game directory
 	if err != nil {
-		game.logger.Fatal("Error getting baseDir: ", err)
+		return fmt.Errorf("terminus: error getting baseDir: %w", err)
 	}
 
 	game.logFile, err = os.OpenFile(baseDir+"/"+game.logFileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
 	if err != nil {
-		game.logger.Fatal("Error opening log file: ", err)
+		return fmt.Errorf("terminus: error opening log file: %w", err)
 	}
 
 	game.logger.SetOutput(game.logFile)
 
-	screen, err := tcell.NewScreen()
-	if err != nil {
-		game.logger.Fatal("Error creating screen: ", err)
-	}
+	// a screen may have been injected using SetScreen
+	// or NewHeadlessGame
+	if nil == game.screen {
 
-	game.screen = screen
+		screen, err := tcell.NewScreen()
+		if err != nil {
+			game.logFile.Close()
+			return fmt.Errorf("terminus: error creating screen: %w", err)
+		}
+
+		game.screen = screen
+
+	}
 
 	game.sceneIndex = 0
 	game.scenes = scenes
 
-	if game.fps == 0 {
-		game.fps = 60
+	if game.fps <= 0 {
+		game.fps = defaultFPS
 	}
 
 	for _, s := range game.scenes {
@@ -79,7 +157,23 @@ func (game *Game) Init(scenes []IScene) {
 
 	}
 
-	game.screen.Init()
+	if err := game.screen.Init(); err != nil {
+		game.logFile.Close()
+		return fmt.Errorf("terminus: error initializing screen: %w", err)
+	}
+
+	if sim, ok := game.screen.(tcell.SimulationScreen); ok && game.width > 0 && game.height > 0 {
+		sim.SetSize(game.width, game.height)
+	}
+
+	game.width, game.height = game.screen.Size()
+
+	if !game.mouseDisabled {
+		game.screen.EnableMouse()
+	}
+
+	game.mouseX, game.mouseY = -1, -1
+
 	game.scenes[game.sceneIndex].Init()
 
 	if len(game.scenes[game.sceneIndex].Entities()) > 0 {
@@ -90,189 +184,1273 @@ func (game *Game) Init(scenes []IScene) {
 
 	}
 
-	game.ticker = time.NewTicker(time.Duration(1000000/game.fps) * time.Microsecond)
-	game.chanKeyPress = make(chan *tcell.EventKey)
+	game.scenes[game.sceneIndex].OnEnter()
+
+	game.ticker = time.NewTicker(game.frameDuration())
+	game.initialized = true
 
 	game.logger.Println("Game Init finished")
+
+	return nil
+
+}
+
+// Run initializes the game with the given scenes and
+// starts the game loop. It returns once the game exits
+func (game *Game) Run(scenes []IScene) error {
+
+	if err := game.Init(scenes); err != nil {
+		return err
+	}
+
+	return game.Start()
+
+}
+
+// maxTicksPerFrame limits the number of fixed timestep
+// updates run by a single frame, so that a slow frame
+// can't cause the game to fall further and further behind
+const maxTicksPerFrame = 5
+
+// defaultFPS is the target FPS of a game which does
+// not set one
+const defaultFPS = 60
+
+// IInterpolatedScene can be implemented by a scene that
+// needs the fixed timestep interpolation alpha while
+// drawing. If implemented, DrawInterpolated is fired
+// instead of Draw
+type IInterpolatedScene interface {
+	DrawInterpolated(alpha float64)
+}
+
+// eventQueue holds events received from the screen
+// until the game loop handles them
+type eventQueue struct {
+	mu     sync.Mutex
+	events []tcell.Event
+}
+
+func (q *eventQueue) push(ev tcell.Event) {
+
+	q.mu.Lock()
+	q.events = append(q.events, ev)
+	q.mu.Unlock()
+
+}
+
+// drain removes and returns every queued event
+func (q *eventQueue) drain() []tcell.Event {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	events := q.events
+	q.events = nil
+
+	return events
+
 }
 
 func (game *Game) getInput() {
 
-	screen := game.screen
+	// hand panics over to the game loop, so that
+	// the terminal is restored before exiting
+	defer func() {
+
+		if r := recover(); r != nil {
+			game.inputPanics <- newPanicError(r)
+		}
 
-	var ev tcell.Event
+	}()
 
 	for {
 
-		ev = screen.PollEvent()
+		ev := game.screen.PollEvent()
+
+		// PollEvent returns nil once the screen
+		// has been finalized
+		if nil == ev {
+			return
+		}
+
+		game.handleEvent(ev)
+
+	}
+
+}
+
+func (game *Game) handleEvent(ev tcell.Event) {
+
+	switch ev.(type) {
+
+	case *tcell.EventResize, *tcell.EventKey, *tcell.EventMouse:
+
+		game.events.push(ev)
+
+	default:
+
+	}
+
+}
+
+// handleInput handles every event queued since the
+// last pass on the game loop. Resize events are applied
+// in order, and key and mouse events become the current
+// inputs
+func (game *Game) handleInput() {
+
+	game.clearInput()
+
+	for _, ev := range game.events.drain() {
 
 		switch eventType := ev.(type) {
 
 		case *tcell.EventResize:
 
-			screen.Sync()
-			game.width, game.height = screen.Size()
-			game.scenes[game.sceneIndex].Init()
+			game.resize()
 
 		case *tcell.EventKey:
-			select {
-			case game.chanKeyPress <- eventType:
-			}
 
-		default:
+			input := newKeyEvent(eventType)
+
+			game.inputs = append(game.inputs, input)
+			game.recordInput(input)
+
+		case *tcell.EventMouse:
+
+			game.mouseInputs = append(game.mouseInputs, game.mouseEvents(eventType)...)
 
 		}
 
 	}
 
+	if len(game.inputs) > 0 {
+		game.input = game.inputs[0]
+	}
+
 }
 
-func (game *Game) handleInput() {
+// clearInput clears the inputs of the last update
+func (game *Game) clearInput() {
+
+	game.input = nil
+	game.inputs = nil
+	game.mouseInputs = nil
+
+}
+
+// exitPressed checks if the exit key is one of
+// the current inputs
+func (game *Game) exitPressed() bool {
+
+	for _, ev := range game.inputs {
+
+		if ev.Key() == game.exitKey {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// resize syncs the screen with its new size, and
+// notifies every active scene
+func (game *Game) resize() {
+
+	screen := game.screen
+
+	screen.Sync()
+	game.width, game.height = screen.Size()
+
+	// the frames of a running transition were captured
+	// at the old size, so it skips to the new scene
+	if nil != game.activeTransition {
+		game.endTransition()
+	}
+
+	for _, scene := range game.activeScenes() {
+
+		scene.GetScene().redraw = true
+
+		if camera := scene.GetScene().camera; nil != camera {
+			camera.clamp()
+		}
+
+		scene.OnResize(game.width, game.height)
 
-	select {
-	case game.input = <-game.chanKeyPress:
-	default:
-		game.input = nil
 	}
 
 }
 
-// Start begins listening for input and starts the game loop
-func (game *Game) Start() {
+// Start begins listening for input and starts the game loop.
+// It returns once the exit key is pressed or Quit is called.
+//
+// If the game panics, the terminal is restored, a crash
+// report is written to the log and a *PanicError is returned
+func (game *Game) Start() error {
+	return game.StartContext(context.Background())
+}
+
+// StartContext is like Start, but the game loop also exits
+// cleanly when ctx is cancelled
+func (game *Game) StartContext(ctx context.Context) (err error) {
 
 	game.logger.Println("Game Start running...")
 
-	defer game.logFile.Close()
+	// restore the terminal on every exit path
+	defer func() {
+
+		if r := recover(); r != nil {
+
+			perr := newPanicError(r)
+			game.crash(perr)
+			err = perr
+
+		}
+
+		game.fini()
+		game.logFile.Close()
+
+	}()
 
-	screen := game.screen
 	clock := time.Now()
 
+	game.inputPanics = make(chan *PanicError, 1)
+
 	go game.getInput()
 
-	game.width, game.height = screen.Size()
+	game.width, game.height = game.screen.Size()
 
-game_loop:
 	for {
 
+		// enforce fps
+		select {
+		case <-ctx.Done():
+			game.logger.Println("Game loop cancelled")
+			return nil
+		case perr := <-game.inputPanics:
+			game.crash(perr)
+			return perr
+		case <-game.ticker.C:
+		}
+
 		update := time.Now()
 		delta := update.Sub(clock).Seconds()
 		clock = update
 
-		game.handleInput()
+		if !game.Step(delta) {
+			break
+		}
+
+	}
+
+	game.logger.Println("Game loop exited")
+
+	return nil
+
+}
+
+// Step runs a single pass through the game loop, using
+// delta as the time elapsed since the last pass. Start
+// calls this on every frame, but it can also be called
+// directly to advance a game manually, for example in
+// tests. Returns false once the game has exited
+//
+// When a tick rate is set, the scene is updated zero or
+// more times with a fixed delta, depending on how much
+// time has accumulated. Otherwise it is updated once
+// with delta
+func (game *Game) Step(delta float64) bool {
+
+	if game.finished {
+		return false
+	}
+
+	if game.tickRate > 0 {
+
+		tick := 1 / game.tickRate
 
-		if game.input != nil && game.input.Key() == game.exitKey {
-			screen.Fini()
-			break game_loop
+		game.accumulator += delta
+
+		if game.accumulator > tick*maxTicksPerFrame {
+			game.accumulator = tick * maxTicksPerFrame
 		}
 
-		scene := game.scenes[game.sceneIndex]
-		scene.Update(delta)
+		// input is only handled by a tick, so a frame
+		// without one has none, and the events wait
+		// for the next tick
+		if game.accumulator < tick {
+			game.clearInput()
+		}
 
-		// enforce fps
-		select {
-		case <-game.ticker.C:
-			scene.Draw()
-			continue
+		for game.accumulator >= tick {
+
+			if !game.tick(tick) {
+				return false
+			}
+
+			game.accumulator -= tick
+
+		}
+
+		game.alpha = game.accumulator / tick
+
+	} else {
+
+		if !game.tick(delta) {
+			return false
+		}
+
+		game.alpha = 1
+
+	}
+
+	if nil != game.activeTransition {
+
+		if game.activeTransition.advance(delta) {
+			game.activeTransition.blend().draw(game.screen)
+			return true
 		}
+
+		game.endTransition()
+
 	}
 
-	game.logger.Println("Game loop exited")
+	game.draw()
+
+	return true
 
 }
 
-// NextScene increments the game sceneIndex if
-// we are not already at the last scene
-func (game *Game) NextScene() {
+// tick handles input and updates the current scene
+// once. Returns false once the game has exited
+func (game *Game) tick(delta float64) bool {
 
-	if game.sceneIndex < len(game.scenes)-1 {
-		game.sceneIndex++
+	game.handleInput()
 
-		game.scenes[game.sceneIndex].Init()
+	if game.quit || game.exitPressed() {
+		game.fini()
+		return false
 	}
 
-}
+	if nil != game.activeTransition && game.activeTransition.blockInput {
+		game.clearInput()
+	}
 
-// PrevScene decrements the game sceneIndex if
-// we are not already at the first scene
-func (game *Game) PrevScene() {
+	game.InputTracker().Update(game.inputs, delta)
+	game.Sequences().Update(game.inputs, delta)
+	game.dispatchMouse()
 
-	if game.sceneIndex > 0 {
-		game.sceneIndex--
+	game.current().Update(delta)
 
-		game.scenes[game.sceneIndex].Init()
+	if camera := game.current().GetScene().camera; nil != camera {
+		camera.update(delta)
 	}
 
+	return true
+
 }
 
-// SetScene sets sceneIndex to a specific number
-// to switch to any scene on the fly
-func (game *Game) SetScene(index int) {
+// draw draws the visible scenes. When overlays are
+// showing, each visible scene is drawn from the bottom
+// up on every frame, so that anything drawn to a Canvas
+// is kept, and the screen is shown once, after all of
+// them, if any of them has changed
+func (game *Game) draw() {
 
-	if index < len(game.scenes) && index >= 0 {
+	layers := game.visibleScenes()
 
-		game.sceneIndex = index
+	if len(layers) == 1 {
+		game.drawScene(layers[0])
+		return
+	}
 
-	} else {
+	screen := game.screen
+	screen.Fill(' ', layers[0].GetScene().style)
 
-		game.logger.Printf(
-			"Cannot set sceneIndex to %d, index out of bounds: 0-%d. Falling back to 0",
-			index,
-			len(game.scenes)-1,
-		)
+	game.compositing = true
 
+	for _, layer := range layers {
+		game.drawScene(layer)
 	}
 
-	game.scenes[game.sceneIndex].Init()
+	game.compositing = false
+
+	// a scene drawn while compositing keeps its redraw
+	// flag, along with anything it drew to a Canvas
+	redraw := false
+
+	for _, layer := range layers {
+
+		redraw = redraw || layer.GetScene().redraw
+		layer.GetScene().redraw = false
+
+	}
+
+	if redraw {
+		screen.Show()
+	}
+
+	screen.Clear()
 
 }
 
-// ExitKey gets the assigned exit key
-func (game *Game) ExitKey() tcell.Key {
-	return game.exitKey
+// drawScene fires the Draw function of a single scene
+func (game *Game) drawScene(scene IScene) {
+
+	if interpolated, ok := scene.(IInterpolatedScene); ok {
+		interpolated.DrawInterpolated(game.alpha)
+	} else {
+		scene.Draw()
+	}
+
 }
 
-// SetExitKey sets the game's exit key
-func (game *Game) SetExitKey(exitKey tcell.Key) {
-	game.exitKey = exitKey
+// Quit ends the game loop at the start of the next
+// update, as though the exit key had been pressed.
+// It should be called from game code, such as an
+// Update function. Use StartContext to end the game
+// from another goroutine
+func (game *Game) Quit() {
+	game.quit = true
 }
 
-// GetFPS gets the game's target FPS
-func (game *Game) GetFPS() float64 {
-	return game.fps
+// fini restores the terminal. It is safe to call
+// more than once
+func (game *Game) fini() {
+
+	if game.finished || nil == game.screen {
+		return
+	}
+
+	game.finished = true
+	game.screen.Fini()
+
 }
 
-// SetFPS sets the game's target FPS
-func (game *Game) SetFPS(fps float64) {
-	game.fps = fps
+// Close restores the screen and closes the log file
+// of a game which was advanced with Step instead of
+// Start, which does this itself when it returns. It
+// is safe to call more than once
+func (game *Game) Close() {
+
+	game.fini()
+
+	if nil != game.ticker {
+		game.ticker.Stop()
+	}
+
+	if nil != game.logFile {
+
+		game.logger.SetOutput(os.Stderr)
+		game.logFile.Close()
+		game.logFile = nil
+
+	}
+
 }
 
-// GetLogger gets the game's logger for
-// use in your game
-func (game *Game) GetLogger() *log.Logger {
-	return game.logger
+// PostEvent passes ev to the game as though it had been
+// received from the screen. Events are handled on the
+// next pass through the game loop
+func (game *Game) PostEvent(ev tcell.Event) {
+	game.handleEvent(ev)
 }
 
-// SetLogFileName sets a custom log file name for
-// logger output. Default value is 'terminus.log'
-func (game *Game) SetLogFileName(filename string) {
-	game.logFileName = filename
+// sceneLayer is a scene pushed on top of the current
+// scene. Overlays leave the scenes below them visible
+type sceneLayer struct {
+	scene   IScene
+	overlay bool
 }
 
-// Input gets the current input as an EventKey
-func (game *Game) Input() *tcell.EventKey {
-	return game.input
+// current returns the active scene, which is the top
+// of the scene stack if any scenes have been pushed
+func (game *Game) current() IScene {
+
+	if len(game.sceneStack) > 0 {
+		return game.sceneStack[len(game.sceneStack)-1].scene
+	}
+
+	return game.scenes[game.sceneIndex]
+
 }
 
-// ScreenSize returns the screen size - (width, height)
-func (game *Game) ScreenSize() (int, int) {
+// visibleScenes returns the scenes that are drawn, from
+// the bottom up. Scenes below the active scene are drawn
+// for as long as the scenes above them are overlays
+func (game *Game) visibleScenes() []IScene {
 
-	return game.width, game.height
+	first := len(game.sceneStack)
+
+	for first > 0 && game.sceneStack[first-1].overlay {
+		first--
+	}
+
+	layers := []IScene{}
+
+	if first == 0 {
+		layers = append(layers, game.scenes[game.sceneIndex])
+	} else {
+		first--
+	}
+
+	for _, layer := range game.sceneStack[first:] {
+		layers = append(layers, layer.scene)
+	}
+
+	return layers
+
+}
+
+// activeScenes returns the current scene and every
+// scene below it on the stack, from the bottom up
+func (game *Game) activeScenes() []IScene {
+
+	scenes := []IScene{game.scenes[game.sceneIndex]}
+
+	for _, layer := range game.sceneStack {
+		scenes = append(scenes, layer.scene)
+	}
+
+	return scenes
+
+}
+
+// exitScenes pops every pushed scene and exits the
+// current scene, from the top down
+func (game *Game) exitScenes() {
+
+	for i := len(game.sceneStack) - 1; i >= 0; i-- {
+
+		game.sceneStack[i].scene.OnExit()
+		game.sceneStack[i] = sceneLayer{}
+
+	}
+
+	game.sceneStack = game.sceneStack[:0]
+
+	game.scenes[game.sceneIndex].OnExit()
+
+}
+
+// enterScene switches to the scene at index. The
+// previous scenes are exited first, then the new
+// scene fires Init followed by OnEnter
+func (game *Game) enterScene(index int) {
+
+	game.transitionScenes(func() {
+
+		game.exitScenes()
+
+		game.sceneIndex = index
+
+		game.scenes[game.sceneIndex].Init()
+		game.scenes[game.sceneIndex].OnEnter()
+
+	})
+
+}
+
+// transitionScenes runs change, which switches scenes.
+// When a transition is set, the frames before and after
+// change are captured and the transition is started
+// instead of showing the new scene immediately
+func (game *Game) transitionScenes(change func()) {
+
+	// a drag or release is not sent to the entities
+	// of a scene that is no longer current
+	game.mouseTarget = nil
+
+	if nil == game.transition || !game.initialized || game.finished {
+		change()
+		return
+	}
+
+	from := game.captureFrame()
+
+	game.capturing = true
+	change()
+	game.capturing = false
+
+	to := game.captureFrame()
+
+	game.transition.start(from, to)
+	game.activeTransition = game.transition
+
+}
+
+// captureFrame draws the visible scenes without showing
+// them, and returns the result
+func (game *Game) captureFrame() *Frame {
+
+	screen := game.screen
+	layers := game.visibleScenes()
+
+	screen.Fill(' ', layers[0].GetScene().style)
+
+	game.capturing = true
+
+	// scenes are drawn through Draw, so that anything
+	// they draw to a Canvas is captured too
+	for _, layer := range layers {
+
+		layer.GetScene().redraw = true
+		game.drawScene(layer)
+
+	}
+
+	game.capturing = false
+
+	frame := newScreenFrame(screen)
+	screen.Clear()
+
+	return frame
+
+}
+
+// endTransition finishes the running transition and
+// flags the visible scenes for redraw
+func (game *Game) endTransition() {
+
+	game.activeTransition = nil
+
+	for _, layer := range game.visibleScenes() {
+		layer.GetScene().redraw = true
+	}
+
+}
+
+// NextScene increments the game sceneIndex if
+// we are not already at the last scene. Any
+// pushed scenes are popped
+func (game *Game) NextScene() {
+
+	if game.sceneIndex < len(game.scenes)-1 {
+		game.enterScene(game.sceneIndex + 1)
+	}
+
+}
+
+// PrevScene decrements the game sceneIndex if
+// we are not already at the first scene. Any
+// pushed scenes are popped
+func (game *Game) PrevScene() {
+
+	if game.sceneIndex > 0 {
+		game.enterScene(game.sceneIndex - 1)
+	}
+
+}
+
+// SetScene sets sceneIndex to a specific number
+// to switch to any scene on the fly. Any pushed
+// scenes are popped
+func (game *Game) SetScene(index int) {
+
+	if index >= len(game.scenes) || index < 0 {
+
+		game.logger.Printf(
+			"Cannot set sceneIndex to %d, index out of bounds: 0-%d. Falling back to 0",
+			index,
+			len(game.scenes)-1,
+		)
+
+		index = game.sceneIndex
+
+	}
+
+	game.enterScene(index)
+
+}
+
+// AddScene registers scene under name, so that it can be
+// switched to or pushed by name. Scenes can be added
+// before or after game.Init. Adding a scene under an
+// existing name replaces the previous scene
+func (game *Game) AddScene(name string, scene IScene) {
+
+	if nil == game.sceneNames {
+		game.sceneNames = map[string]IScene{}
+	}
+
+	if old, ok := game.sceneNames[name]; ok {
+
+		if game.isActive(old) {
+			game.logger.Printf("Cannot replace scene %q while it is active", name)
+			return
+		}
+
+		game.RemoveScene(name)
+
+	}
+
+	game.sceneNames[name] = scene
+
+	// a scene may already have been passed to Init, or
+	// added under another name
+	if containsScene(game.scenes, scene) {
+		return
+	}
+
+	game.scenes = append(game.scenes, scene)
+
+	if game.initialized {
+		scene.Setup()
+	}
+
+}
+
+// containsScene checks if scene is one of scenes
+func containsScene(scenes []IScene, scene IScene) bool {
+
+	for _, s := range scenes {
+
+		if s == scene {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// uniqueScenes returns scenes without duplicates,
+// keeping the first of each
+func uniqueScenes(scenes []IScene) []IScene {
+
+	unique := []IScene{}
+
+	for _, scene := range scenes {
+
+		if !containsScene(unique, scene) {
+			unique = append(unique, scene)
+		}
+
+	}
+
+	return unique
+
+}
+
+// RemoveScene unregisters the scene with the given name.
+// Active scenes cannot be removed
+func (game *Game) RemoveScene(name string) {
+
+	scene, ok := game.sceneNames[name]
+	if !ok {
+		game.logger.Printf("Cannot remove scene %q, no scene with that name", name)
+		return
+	}
+
+	if game.isActive(scene) {
+		game.logger.Printf("Cannot remove scene %q while it is active", name)
+		return
+	}
+
+	delete(game.sceneNames, name)
+
+	// the scene is kept while it has another name
+	for _, other := range game.sceneNames {
+
+		if other == scene {
+			return
+		}
+
+	}
+
+	for i, s := range game.scenes {
+
+		if s == scene {
+
+			copy(game.scenes[i:], game.scenes[i+1:])
+			game.scenes[len(game.scenes)-1] = nil
+			game.scenes = game.scenes[:len(game.scenes)-1]
+
+			if i < game.sceneIndex {
+				game.sceneIndex--
+			}
+
+			break
+
+		}
+
+	}
+
+}
+
+// isActive checks if scene is the current scene or
+// is on the scene stack
+func (game *Game) isActive(scene IScene) bool {
+
+	if game.initialized && game.scenes[game.sceneIndex] == scene {
+		return true
+	}
+
+	for _, layer := range game.sceneStack {
+
+		if layer.scene == scene {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// SceneByName returns the scene registered under name.
+// If there is no such scene ok returns false
+func (game *Game) SceneByName(name string) (IScene, bool) {
+
+	scene, ok := game.sceneNames[name]
+	return scene, ok
+
+}
+
+// SetSceneByName switches to the scene registered under
+// name. Any pushed scenes are popped
+func (game *Game) SetSceneByName(name string) {
+
+	scene, ok := game.sceneNames[name]
+	if !ok {
+		game.logger.Printf("Cannot set scene %q, no scene with that name", name)
+		return
+	}
+
+	for i, s := range game.scenes {
+
+		if s == scene {
+			game.SetScene(i)
+			return
+		}
+
+	}
+
+}
+
+// PushScene pushes the scene registered under name on
+// top of the current scene. The scenes below stop being
+// updated and drawn, but keep their state until the
+// pushed scene is popped
+func (game *Game) PushScene(name string) {
+	game.pushScene(name, false)
+}
+
+// PushOverlay pushes the scene registered under name on
+// top of the current scene, like PushScene, but the
+// scenes below it are still drawn underneath it. Useful
+// for pause menus and dialogs
+func (game *Game) PushOverlay(name string) {
+	game.pushScene(name, true)
+}
+
+func (game *Game) pushScene(name string, overlay bool) {
+
+	scene, ok := game.sceneNames[name]
+	if !ok {
+		game.logger.Printf("Cannot push scene %q, no scene with that name", name)
+		return
+	}
+
+	if game.isActive(scene) {
+		game.logger.Printf("Cannot push scene %q, it is already active", name)
+		return
+	}
+
+	game.transitionScenes(func() {
+
+		game.current().OnSuspend()
+
+		game.sceneStack = append(game.sceneStack, sceneLayer{scene, overlay})
+
+		// an overlay is shown on the next composited
+		// draw, with the scenes below it
+		compositing := game.compositing
+		game.compositing = compositing || overlay
+		scene.Init()
+		game.compositing = compositing
+
+		scene.OnEnter()
+
+	})
+
+}
+
+// PopScene removes the top scene pushed with PushScene
+// or PushOverlay, and returns to the scene below it
+func (game *Game) PopScene() {
+
+	if len(game.sceneStack) == 0 {
+		game.logger.Println("Cannot pop scene, no scenes have been pushed")
+		return
+	}
+
+	game.transitionScenes(func() {
+
+		game.current().OnExit()
+
+		game.sceneStack[len(game.sceneStack)-1] = sceneLayer{}
+		game.sceneStack = game.sceneStack[:len(game.sceneStack)-1]
+
+		game.current().GetScene().resume()
+		game.current().OnResume()
+
+	})
+
+}
+
+// GetTransition gets the transition used when
+// switching scenes
+func (game *Game) GetTransition() *Transition {
+	return game.transition
+}
+
+// SetTransition sets the transition used when switching
+// scenes with NextScene, PrevScene, SetScene, PushScene
+// and PopScene. A nil transition switches instantly,
+// which is the default
+func (game *Game) SetTransition(transition *Transition) {
+	game.transition = transition
+}
+
+// Transitioning checks if a scene transition is running
+func (game *Game) Transitioning() bool {
+	return nil != game.activeTransition
+}
+
+// ExitKey gets the assigned exit key
+func (game *Game) ExitKey() Key {
+	return game.exitKey
+}
+
+// SetExitKey sets the game's exit key
+func (game *Game) SetExitKey(exitKey Key) {
+	game.exitKey = exitKey
+}
+
+// GetFPS gets the game's target FPS
+func (game *Game) GetFPS() float64 {
+	return game.fps
+}
+
+// SetFPS sets the game's target FPS. This can be
+// changed while the game is running. An FPS of 0 or
+// less goes back to the default of 60
+func (game *Game) SetFPS(fps float64) {
+
+	if fps <= 0 {
+		fps = defaultFPS
+	}
+
+	game.fps = fps
+
+	if nil != game.ticker {
+		game.ticker.Reset(game.frameDuration())
+	}
+
+}
+
+// frameDuration returns the time between frames
+// at the target FPS
+func (game *Game) frameDuration() time.Duration {
+
+	if duration := time.Duration(1000000/game.fps) * time.Microsecond; duration > 0 {
+		return duration
+	}
+
+	return time.Microsecond
+
+}
+
+// GetTickRate gets the game's fixed timestep update
+// rate in ticks per second
+func (game *Game) GetTickRate() float64 {
+	return game.tickRate
+}
+
+// SetTickRate sets the number of times per second that
+// the scene is updated, independent of the FPS. Each
+// update receives a fixed delta of 1 / tickRate.
+// A tickRate of 0 disables the fixed timestep, which
+// is the default, and updates once per frame
+func (game *Game) SetTickRate(tickRate float64) {
+
+	game.tickRate = tickRate
+	game.accumulator = 0
+
+}
+
+// Alpha gets the interpolation alpha for the current
+// frame: how far the game is between the last fixed
+// timestep update and the next, from 0 to 1. Always 1
+// when no tick rate is set
+func (game *Game) Alpha() float64 {
+	return game.alpha
+}
+
+// Screen gets the tcell Screen that the game renders to
+func (game *Game) Screen() tcell.Screen {
+	return game.screen
+}
+
+// SetScreen sets the tcell Screen that the game renders
+// to, such as a tcell SimulationScreen. Call this before
+// game.Init, otherwise a terminal screen is created
+func (game *Game) SetScreen(screen tcell.Screen) {
+	game.screen = screen
+}
+
+// Frame returns a snapshot of the cells currently shown
+// on the screen. This is only available when the game
+// renders to a tcell SimulationScreen, otherwise nil is
+// returned
+func (game *Game) Frame() *Frame {
+
+	sim, ok := game.screen.(tcell.SimulationScreen)
+	if !ok {
+		return nil
+	}
+
+	return newSimFrame(sim)
+
+}
+
+// GetLogger gets the game's logger for
+// use in your game
+func (game *Game) GetLogger() *log.Logger {
+	return game.logger
+}
+
+// SetLogFileName sets a custom log file name for
+// logger output. Default value is 'terminus.log'
+func (game *Game) SetLogFileName(filename string) {
+	game.logFileName = filename
+}
+
+// Input gets the first input received since the last
+// frame as a KeyEvent, or nil if there was none
+func (game *Game) Input() *KeyEvent {
+	return game.input
+}
+
+// Inputs gets every input received since the last
+// frame as KeyEvents, in the order they were received
+func (game *Game) Inputs() []*KeyEvent {
+	return game.inputs
+}
+
+// InputMap gets the game's InputMap. By default the
+// movement actions are bound to the arrow keys
+func (game *Game) InputMap() *InputMap {
+
+	if nil == game.inputMap {
+
+		game.inputMap = NewInputMap()
+		game.inputMap.AddPreset(PresetArrows)
+
+	}
+
+	return game.inputMap
+
+}
+
+// SetInputMap sets the InputMap used by ActionPressed
+func (game *Game) SetInputMap(inputMap *InputMap) {
+	game.inputMap = inputMap
+}
+
+// ActionPressed checks if any input received since the
+// last frame triggers the named action
+func (game *Game) ActionPressed(action string) bool {
+
+	inputMap := game.InputMap()
+
+	for _, ev := range game.inputs {
+
+		if inputMap.Matches(action, ev) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// InputTracker gets the game's InputTracker, which
+// reports held and released keys
+func (game *Game) InputTracker() *InputTracker {
+
+	if nil == game.tracker {
+		game.tracker = NewInputTracker()
+	}
+
+	return game.tracker
+
+}
+
+// ActionJustPressed checks if a key bound to the named
+// action was pressed during the last update, after not
+// being held
+func (game *Game) ActionJustPressed(action string) bool {
+
+	for _, binding := range game.InputMap().Bindings(action) {
+
+		if game.InputTracker().BindingJustPressed(binding) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// ActionHeld checks if any key bound to the named
+// action is currently held
+func (game *Game) ActionHeld(action string) bool {
+
+	for _, binding := range game.InputMap().Bindings(action) {
+
+		if game.InputTracker().BindingHeld(binding) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// ActionRepeating checks if any key bound to the named
+// action is held and has been repeated by the terminal.
+// Unlike ActionHeld, this is false for a single tap
+func (game *Game) ActionRepeating(action string) bool {
+
+	for _, binding := range game.InputMap().Bindings(action) {
+
+		if game.InputTracker().BindingRepeating(binding) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// ActionJustReleased checks if a key bound to the named
+// action was released during the last update
+func (game *Game) ActionJustReleased(action string) bool {
+
+	for _, binding := range game.InputMap().Bindings(action) {
+
+		if game.InputTracker().BindingJustReleased(binding) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// Sequences gets the game's SequenceMatcher, which
+// detects key sequences such as cheat codes and combos
+func (game *Game) Sequences() *SequenceMatcher {
+
+	if nil == game.sequences {
+		game.sequences = NewSequenceMatcher()
+	}
+
+	return game.sequences
+
+}
+
+// AddSequence adds seq to the game's SequenceMatcher,
+// replacing any Sequence with the same name
+func (game *Game) AddSequence(seq *Sequence) {
+	game.Sequences().Add(seq)
+}
+
+// RemoveSequence removes the named Sequence from the
+// game's SequenceMatcher
+func (game *Game) RemoveSequence(name string) {
+	game.Sequences().Remove(name)
+}
+
+// SequenceCompleted checks if the named Sequence was
+// completed during the last update
+func (game *Game) SequenceCompleted(name string) bool {
+	return game.Sequences().Completed(name)
+}
+
+// MouseInputs fetches the mouse events received since
+// the last frame, in the order they were received
+func (game *Game) MouseInputs() []*MouseEvent {
+	return game.mouseInputs
+}
+
+// MousePosition returns the last known screen position
+// of the mouse. Returns -1, -1 until the mouse has been
+// used
+func (game *Game) MousePosition() (int, int) {
+	return game.mouseX, game.mouseY
+}
+
+// SetMouseEnabled sets whether mouse events are reported.
+// The mouse is enabled by default. Disabling it restores
+// text selection in most terminals
+func (game *Game) SetMouseEnabled(enabled bool) {
+
+	game.mouseDisabled = !enabled
+
+	if !game.initialized {
+		return
+	}
+
+	if enabled {
+		game.screen.EnableMouse()
+	} else {
+		game.screen.DisableMouse()
+	}
+
+}
+
+// MouseEnabled checks if mouse events are reported
+func (game *Game) MouseEnabled() bool {
+	return !game.mouseDisabled
+}
+
+// ScreenSize returns the screen size - (width, height)
+func (game *Game) ScreenSize() (int, int) {
+
+	return game.width, game.height
+
+}
+
+// ColorCount returns the number of colors the terminal
+// can show. Colors that the terminal does not have are
+// drawn as the closest color that it does
+func (game *Game) ColorCount() int {
+
+	if nil == game.screen {
+		return 0
+	}
+
+	return game.screen.Colors()
 
 }
 
-// CurrentScene returns the game's current Scene
+// CurrentScene returns the game's current Scene. This is
+// the top of the scene stack if any scenes have been pushed
 func (game *Game) CurrentScene() *Scene {
 
-	return game.scenes[game.sceneIndex].GetScene()
+	return game.current().GetScene()
 
 }
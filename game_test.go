@@ -0,0 +1,161 @@
+package terminus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gdamore/tcell"
+)
+
+// newTestGame initializes the headless game of scenes,
+// which is closed when the test finishes
+func newTestGame(t *testing.T, scenes ...IScene) *Game {
+
+	t.Helper()
+
+	game := scenes[0].GetScene().game
+
+	if err := game.Init(scenes); nil != err {
+		t.Fatalf("Init: %v", err)
+	}
+
+	t.Cleanup(game.Close)
+
+	return game
+
+}
+
+// inputScene records the runes of the inputs seen
+// while it is drawn
+type inputScene struct {
+	*Scene
+	seen []rune
+}
+
+func (scene *inputScene) Draw() {
+
+	if input := scene.game.Input(); nil != input {
+		scene.seen = append(scene.seen, input.Rune())
+	}
+
+	scene.Scene.Draw()
+
+}
+
+func TestStepWithoutTickClearsInput(t *testing.T) {
+
+	game := NewHeadlessGame(20, 5)
+	scene := &inputScene{Scene: NewScene(game)}
+	newTestGame(t, scene)
+
+	// a tick every third frame
+	game.SetTickRate(20)
+	game.Step(1.0 / 60)
+
+	game.PostEvent(NewKeyEvent(KeyRune, 'a', ModNone).EventKey())
+
+	for i := 0; i < 6; i++ {
+		game.Step(1.0 / 60)
+	}
+
+	if "a" != string(scene.seen) {
+		t.Errorf("input seen while drawing %q, want %q", string(scene.seen), "a")
+	}
+
+}
+
+// countingScene counts how many times it is set up
+// and initialized
+type countingScene struct {
+	*Scene
+	setups int
+	inits  int
+}
+
+func (scene *countingScene) Setup() {
+	scene.setups++
+}
+
+func (scene *countingScene) Init() {
+
+	scene.inits++
+	scene.Scene.Init()
+
+}
+
+func TestInitSkipsDuplicateScenes(t *testing.T) {
+
+	game := NewHeadlessGame(20, 5)
+	menu := &countingScene{Scene: NewScene(game)}
+	pause := &countingScene{Scene: NewScene(game)}
+
+	game.AddScene("menu", menu)
+	game.AddScene("start", menu)
+	game.AddScene("pause", pause)
+	newTestGame(t, menu, pause, menu)
+
+	if 2 != len(game.scenes) {
+		t.Errorf("%d scenes, want 2", len(game.scenes))
+	}
+
+	if 1 != menu.setups || 1 != menu.inits || 1 != pause.setups {
+		t.Errorf("menu set up %d and initialized %d times, pause set up %d times, want 1", menu.setups, menu.inits, pause.setups)
+	}
+
+	// adding a scene again after Init does not set it up
+	game.AddScene("again", pause)
+
+	if 2 != len(game.scenes) || 1 != pause.setups {
+		t.Errorf("%d scenes and pause set up %d times after adding it again, want 2 and 1", len(game.scenes), pause.setups)
+	}
+
+	// the scene is kept while it has another name
+	game.RemoveScene("again")
+
+	if _, ok := game.SceneByName("pause"); !ok || 2 != len(game.scenes) {
+		t.Errorf("pause removed with one of its names")
+	}
+
+	game.RemoveScene("pause")
+
+	if 1 != len(game.scenes) {
+		t.Errorf("%d scenes after removing pause, want 1", len(game.scenes))
+	}
+
+}
+
+func TestResizeEndsTransition(t *testing.T) {
+
+	game := NewHeadlessGame(10, 3)
+	first := NewScene(game)
+	second := NewScene(game)
+	second.Add(NewText(0, 0, "second"))
+	newTestGame(t, first, second)
+
+	game.SetTransition(NewTransition(TransitionFade, 1))
+	game.NextScene()
+	game.Step(0.1)
+
+	if !game.Transitioning() {
+		t.Fatal("not transitioning after NextScene")
+	}
+
+	game.screen.(tcell.SimulationScreen).SetSize(20, 4)
+	game.PostEvent(tcell.NewEventResize(20, 4))
+	game.Step(0.1)
+
+	if game.Transitioning() {
+		t.Error("still transitioning after a resize")
+	}
+
+	frame := game.Frame()
+
+	if width, height := frame.Size(); 20 != width || 4 != height {
+		t.Errorf("frame size = %d, %d, want 20, 4", width, height)
+	}
+
+	if row := frame.Row(0); "second" != strings.TrimRight(row, " ") {
+		t.Errorf("row 0 = %q, want %q", row, "second")
+	}
+
+}
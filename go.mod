@@ -2,4 +2,7 @@ module github.com/Sheep42/terminus
 
 go 1.16
 
-require github.com/gdamore/tcell v1.4.0
+require (
+	github.com/gdamore/tcell v1.4.0
+	github.com/mattn/go-runewidth v0.0.7
+)
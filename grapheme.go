@@ -0,0 +1,117 @@
+package terminus
+
+import (
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// zeroWidthJoiner joins the runes on either side of
+// it into one character, as in many emoji
+const zeroWidthJoiner = '\u200d'
+
+// grapheme is a single character as it is seen on
+// the screen: a base rune, the runes combined with
+// it, and the number of cells it is drawn across
+type grapheme struct {
+	r         rune
+	combining []rune
+	width     int
+	style     Style
+}
+
+// text returns the grapheme as a string
+func (g grapheme) text() string {
+	return string(g.r) + string(g.combining)
+}
+
+// graphemes splits text into the characters seen on
+// the screen. Combining marks, variation selectors and
+// emoji modifiers are combined with the rune before
+// them, runes on either side of a zero width joiner
+// are combined, and so are pairs of regional
+// indicators, which are drawn as a flag
+func graphemes(text string) []grapheme {
+
+	result := []grapheme{}
+	joining := false
+
+	for _, r := range text {
+
+		last := len(result) - 1
+
+		if last >= 0 && (joining || combines(r) || flagPair(result[last], r)) {
+
+			if flagPair(result[last], r) {
+				result[last].width = 2
+			}
+
+			result[last].combining = append(result[last].combining, r)
+			joining = zeroWidthJoiner == r
+
+			continue
+
+		}
+
+		result = append(result, grapheme{r: r, width: runeWidth(r)})
+		joining = false
+
+	}
+
+	return result
+
+}
+
+// combines checks if r is drawn as part of the
+// character before it
+func combines(r rune) bool {
+
+	if zeroWidthJoiner == r || unicode.Is(unicode.M, r) || unicode.Is(unicode.Variation_Selector, r) {
+		return true
+	}
+
+	// emoji skin tone modifiers
+	return r >= 0x1f3fb && r <= 0x1f3ff
+
+}
+
+// flagPair checks if r is the second regional
+// indicator of a flag started by g
+func flagPair(g grapheme, r rune) bool {
+	return 0 == len(g.combining) && regionalIndicator(g.r) && regionalIndicator(r)
+}
+
+// regionalIndicator checks if r is one of the letters
+// which are paired to make flags
+func regionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+// runeWidth returns the number of cells r is drawn
+// across. East Asian wide runes and most emoji take
+// two cells. Anything else takes at least one, so
+// that no two characters are drawn in the same cell
+func runeWidth(r rune) int {
+
+	if width := runewidth.RuneWidth(r); width > 1 {
+		return width
+	}
+
+	return 1
+
+}
+
+// TextWidth returns the number of cells text takes
+// up on the screen, which is not the same as its
+// length for wide or combined characters
+func TextWidth(text string) int {
+
+	width := 0
+
+	for _, g := range graphemes(text) {
+		width += g.width
+	}
+
+	return width
+
+}
@@ -0,0 +1,241 @@
+package terminus
+
+import (
+	"sort"
+
+	"github.com/gdamore/tcell"
+)
+
+// Actions bound by the built-in presets
+const (
+	ActionMoveUp    = "move_up"
+	ActionMoveDown  = "move_down"
+	ActionMoveLeft  = "move_left"
+	ActionMoveRight = "move_right"
+)
+
+// InputPreset is a predefined set of bindings
+// for the movement actions
+type InputPreset int
+
+// Input presets
+const (
+	// PresetArrows binds the arrow keys
+	PresetArrows InputPreset = iota
+
+	// PresetWASD binds w, a, s and d
+	PresetWASD
+
+	// PresetVi binds h, j, k and l
+	PresetVi
+)
+
+// Binding is a key, rune or key combination
+// which triggers an action
+type Binding struct {
+	Key  Key
+	Rune rune
+	Mod  ModMask
+}
+
+// BindKey creates a Binding for a key, such as KeyUp
+func BindKey(key Key) Binding {
+	return Binding{Key: key}
+}
+
+// BindKeyMod creates a Binding for a key pressed with
+// modifiers, such as Shift+KeyUp
+func BindKeyMod(key Key, mod ModMask) Binding {
+	return Binding{Key: key, Mod: mod}
+}
+
+// BindRune creates a Binding for a rune, such as 'w'
+func BindRune(r rune) Binding {
+	return Binding{Key: KeyRune, Rune: r}
+}
+
+// BindRuneMod creates a Binding for a rune pressed
+// with modifiers, such as Alt+'x'
+func BindRuneMod(r rune, mod ModMask) Binding {
+	return Binding{Key: KeyRune, Rune: r, Mod: mod}
+}
+
+// Matches checks if ev is a press of the Binding
+func (binding Binding) Matches(ev *KeyEvent) bool {
+
+	if nil == ev || ev.Key() != binding.Key {
+		return false
+	}
+
+	if binding.Key == KeyRune && ev.Rune() != binding.Rune {
+		return false
+	}
+
+	return normalizeMod(binding.Key, binding.Mod) == normalizeMod(ev.Key(), ev.Modifiers())
+
+}
+
+// normalizeMod drops modifiers which are already implied
+// by the key. Control keys such as KeyCtrlK are reported
+// with or without ModCtrl, and the case of a rune already
+// reflects Shift
+func normalizeMod(key Key, mod ModMask) ModMask {
+
+	if key == KeyRune {
+		return mod &^ ModShift
+	}
+
+	if key <= tcell.KeyCtrlUnderscore {
+
+		switch key {
+		case KeyBackspace, KeyTab, KeyEsc, KeyEnter:
+		default:
+			return mod &^ ModCtrl
+		}
+
+	}
+
+	return mod
+
+}
+
+// InputMap binds named actions, such as "jump", to keys.
+// An action may have any number of bindings, and the
+// bindings can be changed at runtime
+type InputMap struct {
+	actions map[string][]Binding
+}
+
+// NewInputMap creates an empty InputMap
+func NewInputMap() *InputMap {
+
+	im := &InputMap{
+		actions: map[string][]Binding{},
+	}
+
+	return im
+
+}
+
+// Bind adds bindings to action, keeping any existing
+// bindings
+func (im *InputMap) Bind(action string, bindings ...Binding) {
+	im.actions[action] = append(im.actions[action], bindings...)
+}
+
+// Rebind replaces all of the bindings of action
+func (im *InputMap) Rebind(action string, bindings ...Binding) {
+	im.actions[action] = append([]Binding{}, bindings...)
+}
+
+// Unbind removes action and all of its bindings
+func (im *InputMap) Unbind(action string) {
+	delete(im.actions, action)
+}
+
+// UnbindBinding removes a single binding from every
+// action it is bound to
+func (im *InputMap) UnbindBinding(binding Binding) {
+
+	for action, bindings := range im.actions {
+
+		kept := bindings[:0]
+
+		for _, b := range bindings {
+
+			if b != binding {
+				kept = append(kept, b)
+			}
+
+		}
+
+		im.actions[action] = kept
+
+	}
+
+}
+
+// Bindings returns the bindings of action
+func (im *InputMap) Bindings(action string) []Binding {
+	return im.actions[action]
+}
+
+// Actions returns the names of all bound actions,
+// sorted alphabetically
+func (im *InputMap) Actions() []string {
+
+	actions := make([]string, 0, len(im.actions))
+
+	for action := range im.actions {
+		actions = append(actions, action)
+	}
+
+	sort.Strings(actions)
+
+	return actions
+
+}
+
+// Matches checks if ev triggers action
+func (im *InputMap) Matches(action string, ev *KeyEvent) bool {
+
+	for _, binding := range im.actions[action] {
+
+		if binding.Matches(ev) {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// ActionsFor returns the actions triggered by ev,
+// sorted alphabetically
+func (im *InputMap) ActionsFor(ev *KeyEvent) []string {
+
+	actions := []string{}
+
+	for _, action := range im.Actions() {
+
+		if im.Matches(action, ev) {
+			actions = append(actions, action)
+		}
+
+	}
+
+	return actions
+
+}
+
+// AddPreset binds the movement actions to the keys
+// of preset, keeping any existing bindings
+func (im *InputMap) AddPreset(preset InputPreset) {
+
+	switch preset {
+
+	case PresetArrows:
+
+		im.Bind(ActionMoveUp, BindKey(KeyUp))
+		im.Bind(ActionMoveDown, BindKey(KeyDown))
+		im.Bind(ActionMoveLeft, BindKey(KeyLeft))
+		im.Bind(ActionMoveRight, BindKey(KeyRight))
+
+	case PresetWASD:
+
+		im.Bind(ActionMoveUp, BindRune('w'), BindRune('W'))
+		im.Bind(ActionMoveDown, BindRune('s'), BindRune('S'))
+		im.Bind(ActionMoveLeft, BindRune('a'), BindRune('A'))
+		im.Bind(ActionMoveRight, BindRune('d'), BindRune('D'))
+
+	case PresetVi:
+
+		im.Bind(ActionMoveUp, BindRune('k'))
+		im.Bind(ActionMoveDown, BindRune('j'))
+		im.Bind(ActionMoveLeft, BindRune('h'))
+		im.Bind(ActionMoveRight, BindRune('l'))
+
+	}
+
+}
@@ -0,0 +1,115 @@
+package terminus
+
+import (
+	"testing"
+)
+
+func TestNormalizeMod(t *testing.T) {
+
+	tests := []struct {
+		name string
+		key  Key
+		mod  ModMask
+		want ModMask
+	}{
+		{"rune drops shift", KeyRune, ModShift, ModNone},
+		{"rune keeps alt", KeyRune, ModAlt | ModShift, ModAlt},
+		{"rune keeps ctrl", KeyRune, ModCtrl, ModCtrl},
+		{"ctrl key drops ctrl", KeyCtrlK, ModCtrl, ModNone},
+		{"ctrl key keeps alt", KeyCtrlK, ModCtrl | ModAlt, ModAlt},
+		{"backspace keeps ctrl", KeyBackspace, ModCtrl, ModCtrl},
+		{"tab keeps ctrl", KeyTab, ModCtrl, ModCtrl},
+		{"esc keeps ctrl", KeyEsc, ModCtrl, ModCtrl},
+		{"enter keeps ctrl", KeyEnter, ModCtrl, ModCtrl},
+		{"arrow keeps shift", KeyUp, ModShift, ModShift},
+		{"arrow keeps ctrl", KeyLeft, ModCtrl, ModCtrl},
+	}
+
+	for _, test := range tests {
+
+		if got := normalizeMod(test.key, test.mod); got != test.want {
+			t.Errorf("%s: normalizeMod(%v, %v) = %v, want %v", test.name, test.key, test.mod, got, test.want)
+		}
+
+	}
+
+}
+
+func TestBindingMatches(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		binding Binding
+		ev      *KeyEvent
+		want    bool
+	}{
+		{"key", BindKey(KeyUp), NewKeyEvent(KeyUp, 0, ModNone), true},
+		{"other key", BindKey(KeyUp), NewKeyEvent(KeyDown, 0, ModNone), false},
+		{"key with unbound modifier", BindKey(KeyUp), NewKeyEvent(KeyUp, 0, ModShift), false},
+		{"key with modifier", BindKeyMod(KeyUp, ModShift), NewKeyEvent(KeyUp, 0, ModShift), true},
+		{"key missing modifier", BindKeyMod(KeyUp, ModShift), NewKeyEvent(KeyUp, 0, ModNone), false},
+		{"rune", BindRune('w'), NewKeyEvent(KeyRune, 'w', ModNone), true},
+		{"other rune", BindRune('w'), NewKeyEvent(KeyRune, 'W', ModNone), false},
+		{"rune with shift", BindRune('W'), NewKeyEvent(KeyRune, 'W', ModShift), true},
+		{"rune with alt", BindRuneMod('x', ModAlt), NewKeyEvent(KeyRune, 'x', ModAlt), true},
+		{"rune missing alt", BindRuneMod('x', ModAlt), NewKeyEvent(KeyRune, 'x', ModNone), false},
+		{"ctrl key without ctrl", BindKey(KeyCtrlK), NewKeyEvent(KeyCtrlK, 0, ModNone), true},
+		{"ctrl key with ctrl", BindKey(KeyCtrlK), NewKeyEvent(KeyCtrlK, 0, ModCtrl), true},
+		{"ctrl key bound with ctrl", BindKeyMod(KeyCtrlK, ModCtrl), NewKeyEvent(KeyCtrlK, 0, ModNone), true},
+		{"control rune", BindKey(KeyCtrlA), NewKeyEvent(KeyRune, 1, ModNone), true},
+		{"nil event", BindKey(KeyUp), nil, false},
+	}
+
+	for _, test := range tests {
+
+		if got := test.binding.Matches(test.ev); got != test.want {
+			t.Errorf("%s: Matches = %v, want %v", test.name, got, test.want)
+		}
+
+	}
+
+}
+
+func TestInputMapActionsFor(t *testing.T) {
+
+	im := NewInputMap()
+	im.AddPreset(PresetArrows)
+	im.AddPreset(PresetWASD)
+	im.Bind("jump", BindRune(' '), BindKey(KeyUp))
+
+	tests := []struct {
+		ev   *KeyEvent
+		want []string
+	}{
+		{NewKeyEvent(KeyUp, 0, ModNone), []string{"jump", ActionMoveUp}},
+		{NewKeyEvent(KeyRune, 'A', ModShift), []string{ActionMoveLeft}},
+		{NewKeyEvent(KeyRune, 'q', ModNone), []string{}},
+	}
+
+	for _, test := range tests {
+
+		got := im.ActionsFor(test.ev)
+
+		if len(got) != len(test.want) {
+			t.Errorf("ActionsFor(%s) = %v, want %v", test.ev.Name(), got, test.want)
+			continue
+		}
+
+		for i := range got {
+
+			if got[i] != test.want[i] {
+				t.Errorf("ActionsFor(%s) = %v, want %v", test.ev.Name(), got, test.want)
+				break
+			}
+
+		}
+
+	}
+
+	im.UnbindBinding(BindKey(KeyUp))
+
+	if im.Matches("jump", NewKeyEvent(KeyUp, 0, ModNone)) || im.Matches(ActionMoveUp, NewKeyEvent(KeyUp, 0, ModNone)) {
+		t.Error("KeyUp still matches after UnbindBinding")
+	}
+
+}
@@ -0,0 +1,222 @@
+package terminus
+
+// Default InputTracker timeouts, in seconds
+const (
+	DefaultInitialTimeout = 0.5
+	DefaultRepeatTimeout  = 0.1
+)
+
+// keyID identifies a key or rune tracked by
+// an InputTracker
+type keyID struct {
+	key Key
+	r   rune
+}
+
+func newKeyID(key Key, r rune) keyID {
+
+	if key != KeyRune {
+		r = 0
+	}
+
+	return keyID{key, r}
+
+}
+
+// keyState is the tracked state of a single key
+type keyState struct {
+	lastSeen     float64
+	repeating    bool
+	held         bool
+	justPressed  bool
+	justReleased bool
+}
+
+// InputTracker emulates held and released key states.
+// Terminals only report key presses, so a key is
+// considered held for as long as the OS keeps repeating
+// it, and released once no repeat arrives in time.
+//
+// The first repeat of a key takes longer to arrive than
+// the following ones, so there are two timeouts: the
+// initial timeout applies until a key repeats, and the
+// repeat timeout applies afterwards
+type InputTracker struct {
+	initialTimeout float64
+	repeatTimeout  float64
+	time           float64
+	keys           map[keyID]*keyState
+}
+
+// NewInputTracker creates an InputTracker with the
+// default timeouts
+func NewInputTracker() *InputTracker {
+
+	it := &InputTracker{
+		initialTimeout: DefaultInitialTimeout,
+		repeatTimeout:  DefaultRepeatTimeout,
+		keys:           map[keyID]*keyState{},
+	}
+
+	return it
+
+}
+
+// SetTimeouts sets how long, in seconds, a key stays
+// held without being repeated. initial applies until
+// the key first repeats, and repeat afterwards
+func (it *InputTracker) SetTimeouts(initial, repeat float64) {
+
+	it.initialTimeout = initial
+	it.repeatTimeout = repeat
+
+}
+
+// GetTimeouts gets the initial and repeat timeouts
+func (it *InputTracker) GetTimeouts() (float64, float64) {
+	return it.initialTimeout, it.repeatTimeout
+}
+
+// Update advances the InputTracker by delta, with the
+// inputs received since the last update. Game calls
+// this on every update, so it only needs to be called
+// for an InputTracker that you have created yourself
+func (it *InputTracker) Update(inputs []*KeyEvent, delta float64) {
+
+	it.time += delta
+
+	for id, state := range it.keys {
+
+		state.justPressed = false
+
+		if state.justReleased {
+			delete(it.keys, id)
+		}
+
+	}
+
+	for _, ev := range inputs {
+
+		id := newKeyID(ev.Key(), ev.Rune())
+		state, ok := it.keys[id]
+
+		if !ok || !state.held {
+
+			state = &keyState{
+				held:        true,
+				justPressed: true,
+			}
+
+			it.keys[id] = state
+
+		} else if state.lastSeen < it.time {
+
+			state.repeating = true
+
+		}
+
+		state.lastSeen = it.time
+
+	}
+
+	for _, state := range it.keys {
+
+		timeout := it.initialTimeout
+
+		if state.repeating {
+			timeout = it.repeatTimeout
+		}
+
+		if state.held && it.time-state.lastSeen > timeout {
+
+			state.held = false
+			state.repeating = false
+			state.justReleased = true
+
+		}
+
+	}
+
+}
+
+func (it *InputTracker) state(id keyID) *keyState {
+
+	if state, ok := it.keys[id]; ok {
+		return state
+	}
+
+	return &keyState{}
+
+}
+
+// JustPressed checks if key was pressed during the
+// last update, after not being held
+func (it *InputTracker) JustPressed(key Key) bool {
+	return it.state(newKeyID(key, 0)).justPressed
+}
+
+// Held checks if key is currently held
+func (it *InputTracker) Held(key Key) bool {
+	return it.state(newKeyID(key, 0)).held
+}
+
+// JustReleased checks if key was released during
+// the last update
+func (it *InputTracker) JustReleased(key Key) bool {
+	return it.state(newKeyID(key, 0)).justReleased
+}
+
+// Repeating checks if key is held and has been repeated
+// by the terminal, which only happens once it has been
+// held down for a while. A single tap is held until the
+// initial timeout, but never repeats
+func (it *InputTracker) Repeating(key Key) bool {
+	return it.state(newKeyID(key, 0)).repeating
+}
+
+// RuneJustPressed checks if the rune r was pressed
+// during the last update, after not being held
+func (it *InputTracker) RuneJustPressed(r rune) bool {
+	return it.state(newKeyID(KeyRune, r)).justPressed
+}
+
+// RuneHeld checks if the rune r is currently held
+func (it *InputTracker) RuneHeld(r rune) bool {
+	return it.state(newKeyID(KeyRune, r)).held
+}
+
+// RuneJustReleased checks if the rune r was released
+// during the last update
+func (it *InputTracker) RuneJustReleased(r rune) bool {
+	return it.state(newKeyID(KeyRune, r)).justReleased
+}
+
+// RuneRepeating checks if the rune r is held and has
+// been repeated by the terminal
+func (it *InputTracker) RuneRepeating(r rune) bool {
+	return it.state(newKeyID(KeyRune, r)).repeating
+}
+
+// BindingHeld checks if the key or rune of binding is
+// currently held. Modifiers are not tracked
+func (it *InputTracker) BindingHeld(binding Binding) bool {
+	return it.state(newKeyID(binding.Key, binding.Rune)).held
+}
+
+// BindingJustPressed checks if the key or rune of
+// binding was pressed during the last update
+func (it *InputTracker) BindingJustPressed(binding Binding) bool {
+	return it.state(newKeyID(binding.Key, binding.Rune)).justPressed
+}
+
+// BindingJustReleased checks if the key or rune of
+// binding was released during the last update
+func (it *InputTracker) BindingJustReleased(binding Binding) bool {
+	return it.state(newKeyID(binding.Key, binding.Rune)).justReleased
+}
+
+// BindingRepeating checks if the key or rune of binding
+// is held and has been repeated by the terminal
+func (it *InputTracker) BindingRepeating(binding Binding) bool {
+	return it.state(newKeyID(binding.Key, binding.Rune)).repeating
+}
@@ -0,0 +1,117 @@
+package terminus
+
+import (
+	"testing"
+)
+
+func TestInputTrackerTap(t *testing.T) {
+
+	it := NewInputTracker()
+	right := NewKeyEvent(KeyRight, 0, ModNone)
+
+	it.Update([]*KeyEvent{right}, 0.1)
+
+	if !it.JustPressed(KeyRight) || !it.Held(KeyRight) || it.Repeating(KeyRight) {
+		t.Fatal("a tap is not just pressed and held without repeating")
+	}
+
+	it.Update(nil, 0.1)
+
+	if it.JustPressed(KeyRight) || !it.Held(KeyRight) {
+		t.Error("a tap is not held after the update it was pressed on")
+	}
+
+	// held until the initial timeout
+	it.Update(nil, 0.35)
+
+	if !it.Held(KeyRight) || it.JustReleased(KeyRight) {
+		t.Error("a tap is released before the initial timeout")
+	}
+
+	it.Update(nil, 0.1)
+
+	if it.Held(KeyRight) || !it.JustReleased(KeyRight) || it.Repeating(KeyRight) {
+		t.Error("a tap is not released after the initial timeout")
+	}
+
+	it.Update(nil, 0.1)
+
+	if it.JustReleased(KeyRight) {
+		t.Error("a key is just released on more than one update")
+	}
+
+}
+
+func TestInputTrackerRepeat(t *testing.T) {
+
+	it := NewInputTracker()
+	up := NewKeyEvent(KeyUp, 0, ModNone)
+
+	it.Update([]*KeyEvent{up}, 0.1)
+	it.Update([]*KeyEvent{up}, 0.3)
+
+	if !it.Repeating(KeyUp) || !it.Held(KeyUp) || it.JustPressed(KeyUp) {
+		t.Fatal("a key is not repeating after a second press")
+	}
+
+	// the repeat timeout applies once a key repeats
+	it.Update(nil, 0.05)
+
+	if !it.Held(KeyUp) {
+		t.Error("a repeating key is released before the repeat timeout")
+	}
+
+	it.Update(nil, 0.1)
+
+	if it.Held(KeyUp) || it.Repeating(KeyUp) || !it.JustReleased(KeyUp) {
+		t.Error("a repeating key is not released after the repeat timeout")
+	}
+
+	// pressed again after being released
+	it.Update([]*KeyEvent{up}, 0.1)
+
+	if !it.JustPressed(KeyUp) || it.Repeating(KeyUp) {
+		t.Error("a key pressed again after a release is not just pressed")
+	}
+
+}
+
+func TestInputTrackerSameUpdate(t *testing.T) {
+
+	it := NewInputTracker()
+	w := NewKeyEvent(KeyRune, 'w', ModNone)
+
+	// presses in the same update are not a repeat
+	it.Update([]*KeyEvent{w, w}, 0.1)
+
+	if !it.RuneJustPressed('w') || it.RuneRepeating('w') {
+		t.Error("two presses in one update count as a repeat")
+	}
+
+	if it.RuneHeld('a') || it.BindingHeld(BindRune('a')) {
+		t.Error("a key that was never pressed is held")
+	}
+
+	if !it.BindingHeld(BindRuneMod('w', ModAlt)) {
+		t.Error("the modifiers of a binding are tracked")
+	}
+
+}
+
+func TestInputTrackerTimeouts(t *testing.T) {
+
+	it := NewInputTracker()
+	it.SetTimeouts(1, 0.5)
+
+	if initial, repeat := it.GetTimeouts(); 1 != initial || 0.5 != repeat {
+		t.Errorf("timeouts = %v, %v, want 1, 0.5", initial, repeat)
+	}
+
+	it.Update([]*KeyEvent{NewKeyEvent(KeyEnter, 0, ModNone)}, 0.1)
+	it.Update(nil, 0.9)
+
+	if !it.Held(KeyEnter) {
+		t.Error("a key is released before the initial timeout set")
+	}
+
+}
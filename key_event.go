@@ -0,0 +1,75 @@
+package terminus
+
+import "github.com/gdamore/tcell"
+
+// KeyEvent is a single key press
+type KeyEvent struct {
+	ev *tcell.EventKey
+}
+
+// NewKeyEvent creates a KeyEvent. r is only used when
+// key is KeyRune. Control characters passed as a rune
+// are turned into their keys, such as KeyCtrlA
+func NewKeyEvent(key Key, r rune, mod ModMask) *KeyEvent {
+	return newKeyEvent(tcell.NewEventKey(key, r, mod))
+}
+
+// newKeyEvent wraps a tcell key event
+func newKeyEvent(ev *tcell.EventKey) *KeyEvent {
+	return &KeyEvent{ev}
+}
+
+// Key returns the key that was pressed. Printable
+// characters are reported as KeyRune
+func (ev *KeyEvent) Key() Key {
+	return ev.ev.Key()
+}
+
+// Rune returns the character that was typed when the
+// key is KeyRune
+func (ev *KeyEvent) Rune() rune {
+	return ev.ev.Rune()
+}
+
+// Modifiers returns the modifier keys that were held
+func (ev *KeyEvent) Modifiers() ModMask {
+	return ev.ev.Modifiers()
+}
+
+// Ctrl checks if Ctrl was held
+func (ev *KeyEvent) Ctrl() bool {
+	return 0 != ev.Modifiers()&ModCtrl
+}
+
+// Alt checks if Alt was held
+func (ev *KeyEvent) Alt() bool {
+	return 0 != ev.Modifiers()&ModAlt
+}
+
+// Shift checks if Shift was held. Terminals don't
+// report Shift for most characters, since the case
+// of the rune already reflects it
+func (ev *KeyEvent) Shift() bool {
+	return 0 != ev.Modifiers()&ModShift
+}
+
+// IsKey checks if key was pressed
+func (ev *KeyEvent) IsKey(key Key) bool {
+	return ev.Key() == key
+}
+
+// IsRune checks if the character r was typed
+func (ev *KeyEvent) IsRune(r rune) bool {
+	return ev.Key() == KeyRune && ev.Rune() == r
+}
+
+// Name returns a printable name for the key press,
+// such as "Ctrl+C" or "Rune[z]"
+func (ev *KeyEvent) Name() string {
+	return ev.ev.Name()
+}
+
+// EventKey returns the tcell event behind the KeyEvent
+func (ev *KeyEvent) EventKey() *tcell.EventKey {
+	return ev.ev
+}
@@ -0,0 +1,177 @@
+package terminus
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// markupAttrs are the attribute tags of markup, each
+// of which can be turned off with a leading slash
+var markupAttrs = map[string]AttrMask{
+	"b":         AttrBold,
+	"bold":      AttrBold,
+	"i":         AttrItalic,
+	"italic":    AttrItalic,
+	"u":         AttrUnderline,
+	"underline": AttrUnderline,
+	"d":         AttrDim,
+	"dim":       AttrDim,
+	"r":         AttrReverse,
+	"reverse":   AttrReverse,
+	"blink":     AttrBlink,
+}
+
+// StripMarkup returns text with markup tags, without
+// the tags. See Text.SetMarkup
+func StripMarkup(markup string) string {
+	return graphemesText(parseMarkup(markup))
+}
+
+// EscapeMarkup returns text which is shown as it is
+// when used as markup, for text such as player names
+// that may contain brackets
+func EscapeMarkup(text string) string {
+	return strings.ReplaceAll(text, "[", "[[")
+}
+
+// parseMarkup splits markup into the characters seen
+// on the screen, each with the Style given by the tags
+// before it
+func parseMarkup(markup string) []grapheme {
+
+	chars := []grapheme{}
+	style := Style{}
+
+	for "" != markup {
+
+		open := strings.IndexByte(markup, '[')
+
+		if open < 0 {
+			chars = appendStyled(chars, markup, style)
+			break
+		}
+
+		chars = appendStyled(chars, markup[:open], style)
+		markup = markup[open:]
+
+		// [[ is a literal [
+		if strings.HasPrefix(markup, "[[") {
+			chars = appendStyled(chars, "[", style)
+			markup = markup[2:]
+			continue
+		}
+
+		if end := strings.IndexByte(markup, ']'); end > 0 {
+
+			if next, ok := applyTag(style, markup[1:end]); ok {
+				style = next
+				markup = markup[end+1:]
+				continue
+			}
+
+		}
+
+		// not a tag, so the bracket is shown
+		chars = appendStyled(chars, "[", style)
+		markup = markup[1:]
+
+	}
+
+	return chars
+
+}
+
+// appendStyled appends the characters of text to chars
+// with the given Style
+func appendStyled(chars []grapheme, text string, style Style) []grapheme {
+
+	for _, g := range graphemes(text) {
+		g.style = style
+		chars = append(chars, g)
+	}
+
+	return chars
+
+}
+
+// applyTag returns style changed by the markup tag. If
+// tag is not a valid tag ok returns false
+func applyTag(style Style, tag string) (Style, bool) {
+
+	if "-" == tag {
+		return Style{}, true
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(tag, "/"))
+
+	if attrs, ok := markupAttrs[name]; ok {
+		return style.Attribute(attrs, name == strings.ToLower(tag)), true
+	}
+
+	parts := strings.Split(tag, ":")
+
+	if len(parts) > 2 || "" == strings.Join(parts, "") {
+		return style, false
+	}
+
+	for i, part := range parts {
+
+		if "" == part {
+			continue
+		}
+
+		// - goes back to the color of the Text
+		if "-" == part {
+
+			if 0 == i {
+				style.fg, style.fgSet = 0, false
+			} else {
+				style.bg, style.bgSet = 0, false
+			}
+
+			continue
+
+		}
+
+		color, ok := markupColor(part)
+
+		if !ok {
+			return style, false
+		}
+
+		if 0 == i {
+			style = style.Foreground(color)
+		} else {
+			style = style.Background(color)
+		}
+
+	}
+
+	return style, true
+
+}
+
+// markupColor returns the color with the given name,
+// or hex value starting with #
+func markupColor(name string) (Color, bool) {
+
+	if strings.HasPrefix(name, "#") {
+
+		color := ColorHex(name)
+
+		return color, ColorDefault != color
+
+	}
+
+	name = strings.ToLower(name)
+
+	if "default" == name {
+		return ColorDefault, true
+	}
+
+	color, ok := tcell.ColorNames[name]
+
+	return color, ok
+
+}
@@ -0,0 +1,119 @@
+package terminus
+
+import (
+	"testing"
+)
+
+func TestStripMarkup(t *testing.T) {
+
+	tests := []struct {
+		markup string
+		want   string
+	}{
+		{"", ""},
+		{"plain", "plain"},
+		{"[red]hi[-]", "hi"},
+		{"[b][red]x[/b]y", "xy"},
+		{"[:blue]x", "x"},
+		{"[#ff8800:default]x", "x"},
+		{"[[red]", "[red]"},
+		{"[[[b]x", "[x"},
+		{"a]b", "a]b"},
+		{"[red", "[red"},
+		{"[b]x[red", "x[red"},
+		{"[]", "[]"},
+		{"[:]", "[:]"},
+		{"[nope]x", "[nope]x"},
+		{"[red:blue:green]x", "[red:blue:green]x"},
+		{"[#zzzzzz]x", "[#zzzzzz]x"},
+		{"[日本]", "[日本]"},
+	}
+
+	for _, test := range tests {
+
+		if got := StripMarkup(test.markup); got != test.want {
+			t.Errorf("StripMarkup(%q) = %q, want %q", test.markup, got, test.want)
+		}
+
+	}
+
+}
+
+func TestParseMarkupStyles(t *testing.T) {
+
+	red := Style{}.Foreground(Red)
+	redBold := red.Attribute(AttrBold, true)
+	redBoldOnBlue := redBold.Background(Blue)
+	redOnBlue := redBoldOnBlue.Attribute(AttrBold, false)
+	boldOff := Style{}.Attribute(AttrBold, true).Attribute(AttrBold, false)
+
+	tests := []struct {
+		name   string
+		markup string
+		want   []Style
+	}{
+		{
+			"nested tags",
+			"a[red]b[b]c[:blue]d[/b]e[-:-]f[-]g",
+			[]Style{{}, red, redBold, redBoldOnBlue, redOnBlue, boldOff, {}},
+		},
+		{
+			"unclosed tags carry on to the end",
+			"[red]a[b]b",
+			[]Style{red, redBold},
+		},
+		{
+			"unclosed bracket keeps the style",
+			"[b][red]a[b",
+			[]Style{redBold, redBold, redBold},
+		},
+		{
+			"escaped bracket keeps the style",
+			"[red][[b]",
+			[]Style{red, red, red},
+		},
+	}
+
+	for _, test := range tests {
+
+		chars := parseMarkup(test.markup)
+
+		if len(chars) != len(test.want) {
+			t.Errorf("%s: %d characters, want %d", test.name, len(chars), len(test.want))
+			continue
+		}
+
+		for i, g := range chars {
+
+			if g.style != test.want[i] {
+				t.Errorf("%s: character %d %q has style %+v, want %+v", test.name, i, g.r, g.style, test.want[i])
+			}
+
+		}
+
+	}
+
+}
+
+func TestEscapeMarkup(t *testing.T) {
+
+	for _, text := range []string{"[red]", "a[[b", "[b]]", "[", "[-]", "no brackets"} {
+
+		chars := parseMarkup(EscapeMarkup(text))
+
+		if got := graphemesText(chars); got != text {
+			t.Errorf("EscapeMarkup(%q) is shown as %q", text, got)
+		}
+
+		for _, g := range chars {
+
+			if (Style{}) != g.style {
+				t.Errorf("EscapeMarkup(%q) is styled %+v", text, g.style)
+				break
+			}
+
+		}
+
+	}
+
+}
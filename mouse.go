@@ -0,0 +1,249 @@
+package terminus
+
+import "github.com/gdamore/tcell"
+
+// MouseAction is the kind of a MouseEvent
+type MouseAction int
+
+// Mouse actions
+const (
+	// MouseClick is fired when a button is pressed
+	MouseClick MouseAction = iota
+
+	// MouseRelease is fired when a button is released
+	MouseRelease
+
+	// MouseDrag is fired when the mouse moves while a
+	// button is held
+	MouseDrag
+
+	// MouseWheel is fired when the wheel is scrolled
+	MouseWheel
+
+	// MouseHover is fired when the mouse moves while no
+	// button is held
+	MouseHover
+)
+
+// wheelMask holds the wheel bits of a tcell ButtonMask
+const wheelMask = tcell.WheelUp | tcell.WheelDown | tcell.WheelLeft | tcell.WheelRight
+
+// MouseEvent is a single mouse action at a screen
+// position
+type MouseEvent struct {
+	Action MouseAction
+	X      int
+	Y      int
+
+	// Button holds the buttons that were clicked or
+	// released, or the buttons held during a drag
+	Button ButtonMask
+
+	// WheelX and WheelY are the wheel steps of a
+	// MouseWheel event. Negative values scroll up
+	// and left
+	WheelX int
+	WheelY int
+
+	Mod ModMask
+
+	// Entity is the topmost entity under the mouse, or
+	// nil if there is none. Drags and releases go to
+	// the entity that was clicked
+	Entity IEntity
+}
+
+// MouseHandler can be implemented by an entity to
+// receive the mouse events aimed at it. Events are
+// delivered to the topmost entity under the mouse,
+// or to the EntityGroup containing it if the entity
+// is not a MouseHandler itself
+type MouseHandler interface {
+	OnMouse(ev *MouseEvent)
+}
+
+// mouseEvents converts a tcell mouse event into the
+// mouse actions it represents. tcell reports the
+// buttons that are down, so clicks and releases are
+// found by comparing them with the previous event
+func (game *Game) mouseEvents(ev *tcell.EventMouse) []*MouseEvent {
+
+	x, y := ev.Position()
+	buttons := ev.Buttons()
+	held := buttons &^ wheelMask
+	moved := x != game.mouseX || y != game.mouseY
+
+	newEvent := func(action MouseAction, button ButtonMask) *MouseEvent {
+		return &MouseEvent{Action: action, X: x, Y: y, Button: button, Mod: ev.Modifiers()}
+	}
+
+	events := []*MouseEvent{}
+
+	released := game.mouseButtons &^ held
+
+	if 0 != released {
+		events = append(events, newEvent(MouseRelease, released))
+	}
+
+	if clicked := held &^ game.mouseButtons; 0 != clicked {
+
+		events = append(events, newEvent(MouseClick, clicked))
+
+	} else if moved && 0 != held {
+
+		events = append(events, newEvent(MouseDrag, held))
+
+	} else if moved && 0 == held && 0 == released && 0 == buttons&wheelMask {
+
+		events = append(events, newEvent(MouseHover, tcell.ButtonNone))
+
+	}
+
+	if 0 != buttons&wheelMask {
+
+		wheel := newEvent(MouseWheel, buttons&wheelMask)
+
+		if 0 != buttons&tcell.WheelUp {
+			wheel.WheelY--
+		}
+
+		if 0 != buttons&tcell.WheelDown {
+			wheel.WheelY++
+		}
+
+		if 0 != buttons&tcell.WheelLeft {
+			wheel.WheelX--
+		}
+
+		if 0 != buttons&tcell.WheelRight {
+			wheel.WheelX++
+		}
+
+		events = append(events, wheel)
+
+	}
+
+	game.mouseX, game.mouseY = x, y
+	game.mouseButtons = held
+
+	return events
+
+}
+
+// dispatchMouse delivers the current mouse inputs to
+// the entities of the current scene
+func (game *Game) dispatchMouse() {
+
+	for _, ev := range game.mouseInputs {
+
+		path := hitTest(game.current().GetScene().drawOrder(), ev.X, ev.Y)
+
+		if len(path) > 0 {
+			ev.Entity = path[0]
+		}
+
+		// a clicked entity keeps receiving the mouse
+		// until every button is released
+		switch ev.Action {
+
+		case MouseClick:
+
+			if nil == game.mouseTarget {
+				game.mouseTarget = path
+			}
+
+		case MouseDrag, MouseRelease:
+
+			if nil != game.mouseTarget {
+				path = game.mouseTarget
+				ev.Entity = path[0]
+			}
+
+		}
+
+		if ev.Action == MouseRelease && 0 == game.mouseButtons {
+			game.mouseTarget = nil
+		}
+
+		for _, entity := range path {
+
+			if handler, ok := entity.(MouseHandler); ok {
+				handler.OnMouse(ev)
+				break
+			}
+
+		}
+
+	}
+
+}
+
+// entityContainer is implemented by EntityGroup and
+// the types which extend it
+type entityContainer interface {
+	GetEntity() *Entity
+	GetEntities() []IEntity
+	GetDimensions() (int, int)
+}
+
+// hitTest finds the topmost entity drawn at the screen
+// point x, y. The entity is returned first, followed
+// by the groups that contain it. entities must be in
+// draw order, so that the last entity drawn is on top
+func hitTest(entities []IEntity, x, y int) []IEntity {
+
+	for i := len(entities) - 1; i >= 0; i-- {
+
+		entity := entities[i]
+
+		group, ok := entity.(entityContainer)
+
+		if !ok {
+
+			if hits(entity.GetEntity(), x, y) {
+				return []IEntity{entity}
+			}
+
+			continue
+
+		}
+
+		// a group draws its children up to and including
+		// its width and height, see EntityGroup.Draw
+		gx, gy := group.GetEntity().GetScreenPosition()
+		width, height := group.GetDimensions()
+
+		if x < gx || x > gx+width || y < gy || y > gy+height {
+			continue
+		}
+
+		if path := hitTest(sortEntities(group.GetEntities(), false), x, y); len(path) > 0 {
+			return append(path, entity)
+		}
+
+		return []IEntity{entity}
+
+	}
+
+	return nil
+
+}
+
+// hits checks if entity is drawn at the screen point
+// x, y. Entities without a sprite and the transparent
+// cells of a multi-cell Sprite are not drawn
+func hits(entity *Entity, x, y int) bool {
+
+	ex, ey := entity.GetScreenPosition()
+
+	if nil != entity.multiSprite {
+		return entity.multiSprite.opaque(x-ex, y-ey)
+	}
+
+	if 0 == entity.sprite {
+		return false
+	}
+
+	return x >= ex && x < ex+entity.width() && ey == y
+
+}
@@ -0,0 +1,79 @@
+package terminus
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+)
+
+// mouseEntity records the mouse events it receives
+type mouseEntity struct {
+	*Entity
+	events []MouseAction
+}
+
+func (m *mouseEntity) OnMouse(ev *MouseEvent) {
+	m.events = append(m.events, ev.Action)
+}
+
+func newMouseEntity(x, y int) *mouseEntity {
+	return &mouseEntity{Entity: NewSpriteEntity(x, y, '#')}
+}
+
+func TestClickGroupEdges(t *testing.T) {
+
+	game := NewHeadlessGame(10, 5)
+	scene := NewScene(game)
+
+	// a group draws its children up to and including
+	// its width and height
+	edge := newMouseEntity(3, 1)
+	group := NewEntityGroup(2, 1, 3, 1, []IEntity{edge})
+	scene.Add(group)
+
+	newTestGame(t, scene)
+	game.Step(1.0 / 60)
+
+	if c, _ := game.Frame().Cell(5, 2); '#' != c.Rune {
+		t.Fatalf("cell at 5, 2 is %q, want '#'", c.Rune)
+	}
+
+	game.PostEvent(tcell.NewEventMouse(5, 2, MouseLeft, ModNone))
+	game.Step(1.0 / 60)
+
+	if 1 != len(edge.events) || MouseClick != edge.events[0] {
+		t.Errorf("events %v, want a click on the last column of the group", edge.events)
+	}
+
+}
+
+func TestSceneChangeClearsMouseTarget(t *testing.T) {
+
+	game := NewHeadlessGame(10, 5)
+	first, second := NewScene(game), NewScene(game)
+
+	pressed := newMouseEntity(0, 0)
+	first.Add(pressed)
+
+	dragged := newMouseEntity(0, 0)
+	second.Add(dragged)
+
+	newTestGame(t, first, second)
+
+	game.PostEvent(tcell.NewEventMouse(0, 0, MouseLeft, ModNone))
+	game.Step(1.0 / 60)
+
+	game.NextScene()
+
+	game.PostEvent(tcell.NewEventMouse(1, 0, MouseLeft, ModNone))
+	game.Step(1.0 / 60)
+
+	if 1 != len(pressed.events) {
+		t.Errorf("entity of the old scene got %v, want only the click", pressed.events)
+	}
+
+	if 0 != len(dragged.events) {
+		t.Errorf("entity of the new scene got %v, want nothing under the drag", dragged.events)
+	}
+
+}
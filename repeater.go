@@ -0,0 +1,101 @@
+package terminus
+
+// Repeater turns actions that are held down into
+// steps, such as moving one cell at a time. An action
+// steps once when it is pressed, then every delay
+// seconds once the terminal starts repeating it, so a
+// single tap steps exactly once
+type Repeater struct {
+	delay float64
+	timer float64
+}
+
+// NewRepeater creates a Repeater which steps every
+// delay seconds while an action repeats
+func NewRepeater(delay float64) *Repeater {
+
+	repeater := &Repeater{
+		delay: delay,
+	}
+
+	return repeater
+
+}
+
+// SetDelay sets the time in seconds between steps
+// while an action repeats
+func (repeater *Repeater) SetDelay(delay float64) {
+	repeater.delay = delay
+}
+
+// GetDelay gets the time in seconds between steps
+// while an action repeats
+func (repeater *Repeater) GetDelay() float64 {
+	return repeater.delay
+}
+
+// Update advances the Repeater by delta and returns the
+// actions which step on this update. Actions that were
+// just pressed step straight away. Otherwise, once the
+// delay has passed, the actions that are repeating step.
+// It should be called on every update, from the Update
+// function of an Entity or Scene
+func (repeater *Repeater) Update(game *Game, delta float64, actions ...string) []string {
+
+	repeater.timer -= delta
+	stepped := []string{}
+
+	for _, action := range actions {
+
+		if game.ActionJustPressed(action) {
+			stepped = append(stepped, action)
+		}
+
+	}
+
+	if 0 == len(stepped) && repeater.timer <= 0 {
+
+		for _, action := range actions {
+
+			if game.ActionRepeating(action) {
+				stepped = append(stepped, action)
+			}
+
+		}
+
+	}
+
+	if len(stepped) > 0 {
+		repeater.timer = repeater.delay
+	}
+
+	return stepped
+
+}
+
+// Direction advances the Repeater by delta with the
+// movement actions, and returns the direction of the
+// actions which step on this update, -1, 0 or 1 on each
+// axis. Opposite actions cancel each other out
+func (repeater *Repeater) Direction(game *Game, delta float64) (int, int) {
+
+	x, y := 0, 0
+
+	for _, action := range repeater.Update(game, delta, ActionMoveLeft, ActionMoveRight, ActionMoveUp, ActionMoveDown) {
+
+		switch action {
+		case ActionMoveLeft:
+			x--
+		case ActionMoveRight:
+			x++
+		case ActionMoveUp:
+			y--
+		case ActionMoveDown:
+			y++
+		}
+
+	}
+
+	return x, y
+
+}
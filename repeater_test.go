@@ -0,0 +1,128 @@
+package terminus
+
+import (
+	"testing"
+)
+
+// repeaterScene records the direction of each step of
+// its Repeater
+type repeaterScene struct {
+	*Scene
+	repeater *Repeater
+	steps    [][2]int
+}
+
+func (scene *repeaterScene) Update(delta float64) {
+
+	if x, y := scene.repeater.Direction(scene.game, delta); 0 != x || 0 != y {
+		scene.steps = append(scene.steps, [2]int{x, y})
+	}
+
+}
+
+// newRepeaterScene returns a game with a repeaterScene
+// that steps every 0.05 seconds
+func newRepeaterScene(t *testing.T) (*Game, *repeaterScene) {
+
+	game := NewHeadlessGame(20, 5)
+	scene := &repeaterScene{Scene: NewScene(game), repeater: NewRepeater(0.05)}
+	newTestGame(t, scene)
+
+	return game, scene
+
+}
+
+// press posts a press of each key to game
+func press(game *Game, keys ...Key) {
+
+	for _, key := range keys {
+		game.PostEvent(NewKeyEvent(key, 0, ModNone).EventKey())
+	}
+
+}
+
+// step advances game by n frames at 60 FPS
+func step(game *Game, n int) {
+
+	for i := 0; i < n; i++ {
+		game.Step(1.0 / 60)
+	}
+
+}
+
+func TestRepeaterTapStepsOnce(t *testing.T) {
+
+	game, scene := newRepeaterScene(t)
+
+	press(game, KeyRight)
+	step(game, 60)
+
+	if 1 != len(scene.steps) || [2]int{1, 0} != scene.steps[0] {
+		t.Errorf("steps = %v, want one step right", scene.steps)
+	}
+
+}
+
+func TestRepeaterDiagonalTap(t *testing.T) {
+
+	game, scene := newRepeaterScene(t)
+
+	press(game, KeyUp, KeyRight)
+	step(game, 60)
+
+	if 1 != len(scene.steps) || [2]int{1, -1} != scene.steps[0] {
+		t.Errorf("steps = %v, want one step up and right", scene.steps)
+	}
+
+}
+
+func TestRepeaterStepsWhileRepeating(t *testing.T) {
+
+	game, scene := newRepeaterScene(t)
+
+	// the first repeat arrives after the initial
+	// delay, then every other frame
+	press(game, KeyLeft)
+	step(game, 20)
+
+	for i := 0; i < 30; i++ {
+		press(game, KeyLeft)
+		step(game, 2)
+	}
+
+	// one step for the press, then one every 0.05
+	// seconds or so of the 1 second of repeats
+	if n := len(scene.steps); n < 14 || n > 22 {
+		t.Errorf("%d steps while repeating, want 14 to 22", n)
+	}
+
+	for _, s := range scene.steps {
+
+		if [2]int{-1, 0} != s {
+			t.Fatalf("steps = %v, want every step left", scene.steps)
+		}
+
+	}
+
+}
+
+func TestRepeaterPressWhileHolding(t *testing.T) {
+
+	game, scene := newRepeaterScene(t)
+
+	press(game, KeyLeft)
+	step(game, 20)
+	press(game, KeyLeft)
+	step(game, 1)
+
+	scene.steps = nil
+
+	// tapping right while left repeats steps right
+	press(game, KeyLeft, KeyRight)
+	step(game, 1)
+
+	if 1 != len(scene.steps) || [2]int{1, 0} != scene.steps[0] {
+		t.Errorf("steps = %v, want one step right", scene.steps)
+	}
+
+}
@@ -1,6 +1,8 @@
 package terminus
 
 import (
+	"sort"
+
 	"github.com/gdamore/tcell"
 )
 
@@ -9,6 +11,11 @@ import (
 type IScene interface {
 	Setup()
 	Init()
+	OnEnter()
+	OnExit()
+	OnSuspend()
+	OnResume()
+	OnResize(width, height int)
 	Update(delta float64)
 	Draw()
 	Entities() []IEntity
@@ -21,12 +28,15 @@ type IScene interface {
 type Scene struct {
 	game *Game
 
-	foreground tcell.Color
-	background tcell.Color
+	// baseStyle is the Style set on the scene, and
+	// style the tcell style resolved from it
+	baseStyle Style
 
 	entities []IEntity
 	style    tcell.Style
 	redraw   bool
+	camera   *Camera
+	ySort    bool
 }
 
 // NewScene creates a new Scene to be used by a Game
@@ -34,11 +44,12 @@ func NewScene(game *Game) *Scene {
 
 	scene := &Scene{
 		game,
-		White,
-		Black,
+		NewStyle(White, Black),
 		[]IEntity{},
 		tcell.StyleDefault,
 		false,
+		nil,
+		false,
 	}
 
 	return scene
@@ -47,15 +58,16 @@ func NewScene(game *Game) *Scene {
 
 // NewSceneCustom creates a new Scene with custom
 // foreground and background colors
-func NewSceneCustom(game *Game, fg, bg tcell.Color) *Scene {
+func NewSceneCustom(game *Game, fg, bg Color) *Scene {
 
 	scene := &Scene{
 		game,
-		fg,
-		bg,
+		NewStyle(fg, bg),
 		[]IEntity{},
 		tcell.StyleDefault,
 		false,
+		nil,
+		false,
 	}
 
 	return scene
@@ -65,15 +77,13 @@ func NewSceneCustom(game *Game, fg, bg tcell.Color) *Scene {
 // Setup fires ONLY during game.Init and it can be overridden
 func (scene *Scene) Setup() {}
 
-// Init fires during game.Init and when the scene is first
-// entered. It can be overridden
+// Init fires during game.Init and each time the scene is
+// entered, before OnEnter. It can be overridden
 func (scene *Scene) Init() {
 
 	screen := scene.game.screen
 
-	screenStyle := tcell.StyleDefault.
-		Foreground(scene.foreground).
-		Background(scene.background)
+	screenStyle := scene.baseStyle.resolve(tcell.StyleDefault)
 
 	screen.SetStyle(screenStyle)
 	scene.style = screenStyle
@@ -85,6 +95,31 @@ func (scene *Scene) Init() {
 
 }
 
+// OnEnter fires after Init each time the scene becomes
+// the current scene, through game.Init, a scene switch
+// or game.PushScene. It can be overridden
+func (scene *Scene) OnEnter() {}
+
+// OnExit fires when the scene is switched away from or
+// popped, before the next scene is entered. It can be
+// overridden
+func (scene *Scene) OnExit() {}
+
+// OnSuspend fires when another scene is pushed on top
+// of the scene. The scene keeps its state, but is no
+// longer updated. It can be overridden
+func (scene *Scene) OnSuspend() {}
+
+// OnResume fires when the scene pushed on top of the
+// scene is popped, and the scene is current again.
+// It can be overridden
+func (scene *Scene) OnResume() {}
+
+// OnResize fires for every active scene when the screen
+// is resized. The scene is redrawn afterwards. It can
+// be overridden
+func (scene *Scene) OnResize(width, height int) {}
+
 // Update fires on each pass through the game loop and
 // can be overridden. delta is passed in as a parameter,
 // it is the time elapsed since the last pass through the loop
@@ -104,29 +139,42 @@ func (scene *Scene) Update(delta float64) {
 // the game loop. It can be overridden
 func (scene *Scene) Draw() {
 
-	// only redraw when changes are tracked
-	if true != scene.redraw {
+	compositing := scene.game.compositing
+
+	// only redraw when changes are tracked. Scenes
+	// under an overlay are drawn on every frame, and
+	// the game clears their flags once all of them
+	// have been drawn
+	if true != scene.redraw && !compositing {
 		return
 	}
 
 	scene.drawScene()
 
-	scene.redraw = false
+	if !compositing {
+		scene.redraw = false
+	}
 
 }
 
 func (scene *Scene) drawScene() {
 
 	game := scene.game
+	game.drawing = scene
 
-	if len(scene.entities) > 0 {
+	for _, entity := range scene.drawOrder() {
 
-		for _, entity := range scene.entities {
+		entity.Draw()
 
-			entity.Draw()
+	}
 
-		}
+	game.drawing = nil
 
+	// the game shows the screen once every visible
+	// scene has been drawn, or captures it for a
+	// transition
+	if game.compositing || game.capturing {
+		return
 	}
 
 	game.screen.Show()
@@ -134,9 +182,19 @@ func (scene *Scene) drawScene() {
 
 }
 
+// resume restores the scene's screen style and flags it
+// for redraw when it becomes the current scene again
+func (scene *Scene) resume() {
+
+	scene.game.screen.SetStyle(scene.style)
+	scene.redraw = true
+
+}
+
 // Add adds the given entity to the scene. It should be noted
-// that entities are initialized, updated, and drawn in the
-// order that they have been added to the Scene
+// that entities are initialized and updated in the order
+// that they have been added to the Scene. They are drawn
+// in order of their z-index, see Entity.SetZIndex
 func (scene *Scene) Add(entity IEntity) {
 
 	entity.SetScene(scene)
@@ -188,6 +246,129 @@ func (scene *Scene) GetScene() *Scene {
 
 }
 
+// SetStyle sets the Style of the scene. Entities
+// inherit anything that is not set on their own
+// Style from the scene
+func (scene *Scene) SetStyle(style Style) {
+
+	game := scene.game
+
+	scene.baseStyle = style
+	scene.style = style.resolve(tcell.StyleDefault)
+	scene.redraw = true
+
+	if game.initialized && game.current().GetScene() == scene {
+		game.screen.SetStyle(scene.style)
+	}
+
+}
+
+// GetStyle gets the Style of the scene
+func (scene *Scene) GetStyle() Style {
+	return scene.baseStyle
+}
+
+// Camera gets the scene's Camera, creating one at the
+// world origin if the scene doesn't have one yet
+func (scene *Scene) Camera() *Camera {
+
+	if nil == scene.camera {
+		scene.SetCamera(NewCamera())
+	}
+
+	return scene.camera
+
+}
+
+// SetCamera sets the scene's Camera. Pass nil to draw
+// entities at their world positions again
+func (scene *Scene) SetCamera(camera *Camera) {
+
+	if nil != camera {
+		camera.scene = scene
+	}
+
+	scene.camera = camera
+	scene.redraw = true
+
+}
+
+// SetYSort sets whether entities on the same z-index
+// are drawn from the top of the world down, so that
+// entities lower on the screen are drawn in front.
+// Useful for top-down depth
+func (scene *Scene) SetYSort(ySort bool) {
+
+	scene.ySort = ySort
+	scene.redraw = true
+
+}
+
+// IsYSort checks if entities on the same z-index are
+// drawn from the top of the world down
+func (scene *Scene) IsYSort() bool {
+	return scene.ySort
+}
+
+// drawOrder returns the scene's entities in the order
+// that they are drawn
+func (scene *Scene) drawOrder() []IEntity {
+	return sortEntities(scene.entities, scene.ySort)
+}
+
+// sortEntities returns a copy of entities sorted by
+// z-index, and by world y if ySort is set. The sort is
+// stable, so entities that are otherwise equal keep
+// the order they were added in
+func sortEntities(entities []IEntity, ySort bool) []IEntity {
+
+	sorted := append([]IEntity{}, entities...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+
+		a, b := sorted[i].GetEntity(), sorted[j].GetEntity()
+
+		if a.zIndex != b.zIndex || !ySort {
+			return a.zIndex < b.zIndex
+		}
+
+		// entities are sorted by their bottom row, so
+		// that tall sprites sort by where they stand
+		_, ay := a.GetWorldPosition()
+		_, by := b.GetWorldPosition()
+
+		return ay+a.height() < by+b.height()
+
+	})
+
+	return sorted
+
+}
+
+// Canvas returns a Canvas for drawing to the screen
+// in screen positions
+func (scene *Scene) Canvas() *Canvas {
+	return &Canvas{scene: scene}
+}
+
+// WorldCanvas returns a Canvas for drawing to the
+// screen in world positions, as seen through the
+// scene's Camera
+func (scene *Scene) WorldCanvas() *Canvas {
+
+	canvas := &Canvas{scene: scene}
+
+	if nil != scene.camera {
+
+		x, y := scene.camera.GetPosition()
+		canvas.offsetX, canvas.offsetY = -x, -y
+
+	}
+
+	return canvas
+
+}
+
 // SetRedraw allows you to tell a specific scene to
 // redraw (true) or not (false) on the next frame
 func (scene *Scene) SetRedraw(redraw bool) {
@@ -0,0 +1,237 @@
+package terminus
+
+import "sort"
+
+// Sequence is a series of key presses, such as a cheat
+// code or a combo, which must be completed within a
+// time window
+type Sequence struct {
+	name     string
+	window   float64
+	steps    []Binding
+	callback func()
+}
+
+// NewSequence creates a Sequence which completes when
+// the steps are pressed in order, within window seconds
+// of the first step. A window of 0 allows any amount
+// of time
+func NewSequence(name string, window float64, steps ...Binding) *Sequence {
+
+	seq := &Sequence{
+		name:   name,
+		window: window,
+		steps:  steps,
+	}
+
+	return seq
+
+}
+
+// OnComplete sets a callback which fires each time the
+// Sequence is completed
+func (seq *Sequence) OnComplete(callback func()) {
+	seq.callback = callback
+}
+
+// GetName gets the name of the Sequence
+func (seq *Sequence) GetName() string {
+	return seq.name
+}
+
+// GetWindow gets the time window of the Sequence
+// in seconds
+func (seq *Sequence) GetWindow() float64 {
+	return seq.window
+}
+
+// GetSteps gets the key presses of the Sequence
+func (seq *Sequence) GetSteps() []Binding {
+	return seq.steps
+}
+
+// matches checks if the Sequence ends at the last
+// key press of history
+func (seq *Sequence) matches(history []keyPress) bool {
+
+	if 0 == len(seq.steps) || len(history) < len(seq.steps) {
+		return false
+	}
+
+	presses := history[len(history)-len(seq.steps):]
+
+	for i, step := range seq.steps {
+
+		if !step.Matches(presses[i].ev) {
+			return false
+		}
+
+	}
+
+	if seq.window > 0 && presses[len(presses)-1].time-presses[0].time > seq.window {
+		return false
+	}
+
+	return true
+
+}
+
+// keyPress is a key event and the time it was handled
+type keyPress struct {
+	ev   *KeyEvent
+	time float64
+}
+
+// SequenceMatcher detects completed sequences in the
+// key presses it is updated with
+type SequenceMatcher struct {
+	sequences map[string]*Sequence
+	history   []keyPress
+	completed []string
+	time      float64
+}
+
+// NewSequenceMatcher creates an empty SequenceMatcher
+func NewSequenceMatcher() *SequenceMatcher {
+
+	sm := &SequenceMatcher{
+		sequences: map[string]*Sequence{},
+	}
+
+	return sm
+
+}
+
+// Add adds seq, replacing any Sequence with the
+// same name
+func (sm *SequenceMatcher) Add(seq *Sequence) {
+	sm.sequences[seq.name] = seq
+}
+
+// Remove removes the Sequence with the given name
+func (sm *SequenceMatcher) Remove(name string) {
+	delete(sm.sequences, name)
+}
+
+// Get gets the Sequence with the given name. If there
+// is none ok returns false
+func (sm *SequenceMatcher) Get(name string) (*Sequence, bool) {
+
+	seq, ok := sm.sequences[name]
+
+	return seq, ok
+
+}
+
+// Reset forgets every key press made so far, so that
+// no Sequence in progress can complete
+func (sm *SequenceMatcher) Reset() {
+	sm.history = nil
+}
+
+// Update advances the SequenceMatcher by delta, with the
+// inputs received since the last update. Callbacks fire
+// as sequences complete. Game calls this on every update,
+// so it only needs to be called for a SequenceMatcher
+// that you have created yourself
+func (sm *SequenceMatcher) Update(inputs []*KeyEvent, delta float64) {
+
+	sm.time += delta
+	sm.completed = nil
+
+	for _, ev := range inputs {
+
+		sm.history = append(sm.history, keyPress{ev, sm.time})
+
+		if longest := sm.longest(); len(sm.history) > longest {
+			sm.history = sm.history[len(sm.history)-longest:]
+		}
+
+		completed := []*Sequence{}
+
+		for _, name := range sm.names() {
+
+			if seq := sm.sequences[name]; seq.matches(sm.history) {
+				completed = append(completed, seq)
+			}
+
+		}
+
+		// a key press can only complete one set of
+		// sequences, so that double taps and repeated
+		// codes must be pressed again in full
+		if len(completed) > 0 {
+			sm.history = nil
+		}
+
+		for _, seq := range completed {
+
+			sm.completed = append(sm.completed, seq.name)
+
+			if nil != seq.callback {
+				seq.callback()
+			}
+
+		}
+
+	}
+
+}
+
+// Completed checks if the named Sequence was completed
+// during the last update
+func (sm *SequenceMatcher) Completed(name string) bool {
+
+	for _, completed := range sm.completed {
+
+		if completed == name {
+			return true
+		}
+
+	}
+
+	return false
+
+}
+
+// CompletedSequences returns the names of the sequences
+// completed during the last update, in the order they
+// were completed
+func (sm *SequenceMatcher) CompletedSequences() []string {
+	return sm.completed
+}
+
+// names returns the names of the sequences, sorted
+// alphabetically so that callbacks fire in a stable
+// order
+func (sm *SequenceMatcher) names() []string {
+
+	names := make([]string, 0, len(sm.sequences))
+
+	for name := range sm.sequences {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+
+}
+
+// longest returns the number of steps in the longest
+// Sequence, which is as much history as is needed
+func (sm *SequenceMatcher) longest() int {
+
+	longest := 0
+
+	for _, seq := range sm.sequences {
+
+		if len(seq.steps) > longest {
+			longest = len(seq.steps)
+		}
+
+	}
+
+	return longest
+
+}
@@ -0,0 +1,172 @@
+package terminus
+
+import (
+	"strings"
+	"testing"
+)
+
+// runeSequence creates a Sequence of the runes of keys
+func runeSequence(name string, window float64, keys string) *Sequence {
+
+	steps := []Binding{}
+
+	for _, r := range keys {
+		steps = append(steps, BindRune(r))
+	}
+
+	return NewSequence(name, window, steps...)
+
+}
+
+// timedPress is a rune pressed wait seconds after the
+// press before it
+type timedPress struct {
+	r    rune
+	wait float64
+}
+
+func TestSequenceMatcher(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		sequences []*Sequence
+		presses   []timedPress
+		want      []string
+	}{
+		{
+			"in order",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.1}, {'c', 0.1}},
+			[]string{"abc"},
+		},
+		{
+			"out of order",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'c', 0.1}, {'b', 0.1}},
+			nil,
+		},
+		{
+			"interrupted",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.1}, {'x', 0.1}, {'c', 0.1}},
+			nil,
+		},
+		{
+			"stray press before the first step",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'a', 0.1}, {'b', 0.1}, {'c', 0.1}},
+			[]string{"abc"},
+		},
+		{
+			"at the end of the window",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.5}, {'c', 0.5}},
+			[]string{"abc"},
+		},
+		{
+			"past the window",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.6}, {'c', 0.6}},
+			nil,
+		},
+		{
+			"pressed again after timing out",
+			[]*Sequence{runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.6}, {'c', 0.6}, {'a', 0.1}, {'b', 0.1}, {'c', 0.1}},
+			[]string{"abc"},
+		},
+		{
+			"no window",
+			[]*Sequence{runeSequence("abc", 0, "abc")},
+			[]timedPress{{'a', 0}, {'b', 100}, {'c', 100}},
+			[]string{"abc"},
+		},
+		{
+			"prefix completes first",
+			[]*Sequence{runeSequence("ab", 1, "ab"), runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.1}, {'c', 0.1}},
+			[]string{"ab"},
+		},
+		{
+			"shared suffix completes both",
+			[]*Sequence{runeSequence("bc", 1, "bc"), runeSequence("abc", 1, "abc")},
+			[]timedPress{{'a', 0}, {'b', 0.1}, {'c', 0.1}},
+			[]string{"abc", "bc"},
+		},
+		{
+			"double tap is pressed again in full",
+			[]*Sequence{runeSequence("aa", 1, "aa")},
+			[]timedPress{{'a', 0}, {'a', 0.1}, {'a', 0.1}},
+			[]string{"aa"},
+		},
+		{
+			"double tap twice",
+			[]*Sequence{runeSequence("aa", 1, "aa")},
+			[]timedPress{{'a', 0}, {'a', 0.1}, {'a', 0.1}, {'a', 0.1}},
+			[]string{"aa", "aa"},
+		},
+	}
+
+	for _, test := range tests {
+
+		sm := NewSequenceMatcher()
+
+		for _, seq := range test.sequences {
+			sm.Add(seq)
+		}
+
+		var got []string
+
+		for _, press := range test.presses {
+
+			sm.Update([]*KeyEvent{NewKeyEvent(KeyRune, press.r, ModNone)}, press.wait)
+			got = append(got, sm.CompletedSequences()...)
+
+		}
+
+		if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("%s: completed %v, want %v", test.name, got, test.want)
+		}
+
+	}
+
+}
+
+func TestSequenceMatcherReset(t *testing.T) {
+
+	sm := NewSequenceMatcher()
+	sm.Add(runeSequence("abc", 0, "abc"))
+
+	completed := 0
+	seq, _ := sm.Get("abc")
+	seq.OnComplete(func() { completed++ })
+
+	press := func(r rune) {
+		sm.Update([]*KeyEvent{NewKeyEvent(KeyRune, r, ModNone)}, 0.1)
+	}
+
+	press('a')
+	press('b')
+	sm.Reset()
+	press('c')
+
+	if sm.Completed("abc") || 0 != completed {
+		t.Error("sequence completed with presses from before Reset")
+	}
+
+	press('a')
+	press('b')
+	press('c')
+
+	if !sm.Completed("abc") || 1 != completed {
+		t.Errorf("Completed = %v with %d callbacks, want true with 1", sm.Completed("abc"), completed)
+	}
+
+	// completions only last for one update
+	sm.Update(nil, 0.1)
+
+	if sm.Completed("abc") {
+		t.Error("sequence still completed after the next update")
+	}
+
+}
@@ -0,0 +1,274 @@
+package terminus
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// spriteCell is a single cell of a Sprite
+type spriteCell struct {
+	r           rune
+	combining   []rune
+	style       Style
+	transparent bool
+}
+
+// Sprite is a grid of runes, each with its own Style,
+// for things that are bigger than a single cell such
+// as ships, characters and logos. Transparent cells
+// are not drawn, so whatever is behind them shows
+// through
+type Sprite struct {
+	width  int
+	height int
+	cells  [][]spriteCell
+}
+
+// NewSprite creates a Sprite of the given size, with
+// every cell transparent
+func NewSprite(width, height int) *Sprite {
+
+	if width < 0 {
+		width = 0
+	}
+
+	if height < 0 {
+		height = 0
+	}
+
+	sprite := &Sprite{
+		width:  width,
+		height: height,
+		cells:  make([][]spriteCell, height),
+	}
+
+	for row := range sprite.cells {
+
+		sprite.cells[row] = make([]spriteCell, width)
+
+		for col := range sprite.cells[row] {
+			sprite.cells[row][col].transparent = true
+		}
+
+	}
+
+	return sprite
+
+}
+
+// NewSpriteFromString creates a Sprite from text art,
+// one row per line. Spaces are transparent. A leading
+// newline is ignored, so that art can be written in a
+// raw string starting on the line after the backtick.
+// Wide characters take up two cells
+func NewSpriteFromString(art string) *Sprite {
+
+	lines := spriteLines(art)
+	width := 0
+
+	for _, line := range lines {
+
+		if n := TextWidth(line); n > width {
+			width = n
+		}
+
+	}
+
+	sprite := NewSprite(width, len(lines))
+
+	for row, line := range lines {
+
+		col := 0
+
+		for _, g := range graphemes(line) {
+
+			if ' ' != g.r {
+				sprite.SetCell(col, row, g.r, Style{})
+				sprite.cells[row][col].combining = g.combining
+			}
+
+			col += g.width
+
+		}
+
+	}
+
+	return sprite
+
+}
+
+// LoadSprite creates a Sprite from the text art in the
+// file at path, in the same way as NewSpriteFromString
+func LoadSprite(path string) (*Sprite, error) {
+
+	art, err := os.ReadFile(path)
+
+	if nil != err {
+		return nil, fmt.Errorf("terminus: error loading sprite: %w", err)
+	}
+
+	return NewSpriteFromString(string(art)), nil
+
+}
+
+// spriteLines splits text art into rows, ignoring a
+// leading newline and any trailing newlines
+func spriteLines(art string) []string {
+
+	art = strings.ReplaceAll(art, "\r\n", "\n")
+	art = strings.TrimPrefix(art, "\n")
+	art = strings.TrimRight(art, "\n")
+
+	if "" == art {
+		return []string{}
+	}
+
+	return strings.Split(art, "\n")
+
+}
+
+// GetDimensions returns the width and height of
+// the Sprite
+func (sprite *Sprite) GetDimensions() (int, int) {
+	return sprite.width, sprite.height
+}
+
+// SetCell sets the rune and Style of the cell at x, y
+// and makes it opaque. Anything that is not set on
+// style is inherited from the Entity drawing the
+// Sprite. Cells outside of the Sprite are ignored
+func (sprite *Sprite) SetCell(x, y int, r rune, style Style) {
+
+	if !sprite.contains(x, y) {
+		return
+	}
+
+	sprite.cells[y][x] = spriteCell{r: r, style: style}
+
+}
+
+// GetCell gets the rune and Style of the cell at x, y.
+// If the cell is transparent or outside of the Sprite
+// ok returns false
+func (sprite *Sprite) GetCell(x, y int) (rune, Style, bool) {
+
+	if !sprite.opaque(x, y) {
+		return 0, Style{}, false
+	}
+
+	cell := sprite.cells[y][x]
+
+	return cell.r, cell.style, true
+
+}
+
+// SetCellStyle sets the Style of the cell at x, y
+// without changing its rune
+func (sprite *Sprite) SetCellStyle(x, y int, style Style) {
+
+	if sprite.contains(x, y) {
+		sprite.cells[y][x].style = style
+	}
+
+}
+
+// SetTransparent sets whether the cell at x, y is
+// transparent. An opaque space can be used to block
+// out what is behind the Sprite
+func (sprite *Sprite) SetTransparent(x, y int, transparent bool) {
+
+	if !sprite.contains(x, y) {
+		return
+	}
+
+	cell := &sprite.cells[y][x]
+	cell.transparent = transparent
+
+	if 0 == cell.r {
+		cell.r = ' '
+	}
+
+}
+
+// SetStyle sets the Style of every cell of the Sprite
+func (sprite *Sprite) SetStyle(style Style) {
+
+	for row := range sprite.cells {
+
+		for col := range sprite.cells[row] {
+			sprite.cells[row][col].style = style
+		}
+
+	}
+
+}
+
+// SetColorMask colors the Sprite from a mask, which is
+// text art the same shape as the Sprite. Each rune of
+// the mask picks the Style of the matching cell from
+// styles. Runes that are not in styles are skipped.
+// Columns are counted in cells, as they are when the
+// Sprite is created, so a mask lines up with a Sprite
+// that has wide characters in it
+//
+//	ship.SetColorMask(`
+//	 rr
+//	bwwb`, map[rune]t.Style{
+//		'r': t.Style{}.Foreground(t.Red),
+//		'b': t.Style{}.Foreground(t.Blue),
+//		'w': t.Style{}.Foreground(t.White),
+//	})
+func (sprite *Sprite) SetColorMask(mask string, styles map[rune]Style) {
+
+	for row, line := range spriteLines(mask) {
+
+		col := 0
+
+		for _, g := range graphemes(line) {
+
+			if style, ok := styles[g.r]; ok {
+				sprite.SetCellStyle(col, row, style)
+			}
+
+			col += g.width
+
+		}
+
+	}
+
+}
+
+// draw draws the opaque cells of the Sprite with its
+// top left corner at the screen position x, y. Each
+// cell's Style is layered over style. Cells for which
+// clip returns true are skipped
+func (sprite *Sprite) draw(screen tcell.Screen, x, y int, style Style, base tcell.Style, clip func(col, row int) bool) {
+
+	for row, cells := range sprite.cells {
+
+		for col, cell := range cells {
+
+			if cell.transparent || (nil != clip && clip(col, row)) {
+				continue
+			}
+
+			screen.SetContent(x+col, y+row, cell.r, cell.combining, style.Merge(cell.style).resolve(base))
+
+		}
+
+	}
+
+}
+
+// contains checks if x, y is inside of the Sprite
+func (sprite *Sprite) contains(x, y int) bool {
+	return x >= 0 && x < sprite.width && y >= 0 && y < sprite.height
+}
+
+// opaque checks if the cell at x, y is drawn
+func (sprite *Sprite) opaque(x, y int) bool {
+	return sprite.contains(x, y) && !sprite.cells[y][x].transparent
+}
@@ -0,0 +1,158 @@
+package terminus
+
+import "github.com/gdamore/tcell"
+
+// Style describes how cells are drawn. Anything that
+// is not set on a Style is inherited from the scene
+// being drawn, so the zero Style draws with the
+// scene's colors
+type Style struct {
+	fg    Color
+	bg    Color
+	fgSet bool
+	bgSet bool
+
+	// attrsSet holds the attributes that have been set,
+	// turned on or off, and attrs the ones that are on
+	attrs    AttrMask
+	attrsSet AttrMask
+}
+
+// NewStyle creates a Style with the given foreground
+// and background colors
+func NewStyle(fg, bg Color) Style {
+	return Style{}.Foreground(fg).Background(bg)
+}
+
+// Foreground returns a copy of the Style with the
+// given foreground color
+func (style Style) Foreground(fg Color) Style {
+
+	style.fg, style.fgSet = fg, true
+
+	return style
+
+}
+
+// Background returns a copy of the Style with the
+// given background color
+func (style Style) Background(bg Color) Style {
+
+	style.bg, style.bgSet = bg, true
+
+	return style
+
+}
+
+// GetForeground gets the foreground color of the Style.
+// If it is inherited ok returns false
+func (style Style) GetForeground() (Color, bool) {
+	return style.fg, style.fgSet
+}
+
+// GetBackground gets the background color of the Style.
+// If it is inherited ok returns false
+func (style Style) GetBackground() (Color, bool) {
+	return style.bg, style.bgSet
+}
+
+// Bold returns a copy of the Style with bold turned
+// on or off
+func (style Style) Bold(on bool) Style {
+	return style.Attribute(AttrBold, on)
+}
+
+// Underline returns a copy of the Style with underline
+// turned on or off
+func (style Style) Underline(on bool) Style {
+	return style.Attribute(AttrUnderline, on)
+}
+
+// Reverse returns a copy of the Style with reverse
+// video turned on or off
+func (style Style) Reverse(on bool) Style {
+	return style.Attribute(AttrReverse, on)
+}
+
+// Dim returns a copy of the Style with dim turned
+// on or off
+func (style Style) Dim(on bool) Style {
+	return style.Attribute(AttrDim, on)
+}
+
+// Blink returns a copy of the Style with blink turned
+// on or off
+func (style Style) Blink(on bool) Style {
+	return style.Attribute(AttrBlink, on)
+}
+
+// Italic returns a copy of the Style with italic turned
+// on or off. Not every terminal supports italic
+func (style Style) Italic(on bool) Style {
+	return style.Attribute(AttrItalic, on)
+}
+
+// Attribute returns a copy of the Style with the given
+// attributes turned on or off
+func (style Style) Attribute(attrs AttrMask, on bool) Style {
+
+	style.attrsSet |= attrs
+
+	if on {
+		style.attrs |= attrs
+	} else {
+		style.attrs &^= attrs
+	}
+
+	return style
+
+}
+
+// GetAttributes gets the attributes that are turned on,
+// and the attributes that have been set at all. Anything
+// that is not set is inherited
+func (style Style) GetAttributes() (on AttrMask, set AttrMask) {
+	return style.attrs, style.attrsSet
+}
+
+// Merge returns a copy of the Style with everything that
+// has been set on other applied over it
+func (style Style) Merge(other Style) Style {
+
+	if other.fgSet {
+		style = style.Foreground(other.fg)
+	}
+
+	if other.bgSet {
+		style = style.Background(other.bg)
+	}
+
+	style.attrs = style.attrs&^other.attrsSet | other.attrs
+	style.attrsSet |= other.attrsSet
+
+	return style
+
+}
+
+// resolve returns the tcell style to draw with, using
+// base for anything that is not set on the Style
+func (style Style) resolve(base tcell.Style) tcell.Style {
+
+	if style.fgSet {
+		base = base.Foreground(style.fg)
+	}
+
+	if style.bgSet {
+		base = base.Background(style.bg)
+	}
+
+	if 0 != style.attrsSet {
+
+		_, _, attrs := base.Decompose()
+		base = withAttrs(base, attrs&^style.attrsSet|style.attrs)
+
+	}
+
+	return base
+
+}
@@ -4,6 +4,26 @@ import (
 	"github.com/gdamore/tcell"
 )
 
+// Color is a terminal color. It is the same type as
+// tcell.Color, so the two can be used interchangeably
+type Color = tcell.Color
+
+// Key is a key on the keyboard. It is the same type as
+// tcell.Key
+type Key = tcell.Key
+
+// ModMask is a set of modifier keys. It is the same
+// type as tcell.ModMask
+type ModMask = tcell.ModMask
+
+// AttrMask is a set of text attributes. It is the same
+// type as tcell.AttrMask
+type AttrMask = tcell.AttrMask
+
+// ButtonMask is a set of mouse buttons. It is the same
+// type as tcell.ButtonMask
+type ButtonMask = tcell.ButtonMask
+
 // Colors
 const (
 	White      = tcell.ColorWhite
@@ -21,6 +41,10 @@ const (
 	Orange     = tcell.ColorOrange
 	Purple     = tcell.ColorPurple
 	Yellow     = tcell.ColorYellow
+
+	// ColorDefault is the terminal's own foreground
+	// or background color
+	ColorDefault = tcell.ColorDefault
 )
 
 // Event Keys
@@ -31,4 +55,90 @@ const (
 	KeyRight = tcell.KeyRight
 	KeyLeft  = tcell.KeyLeft
 	KeyEnter = tcell.KeyEnter
+
+	// KeyRune is the key of every printable character,
+	// see KeyEvent.Rune
+	KeyRune = tcell.KeyRune
+
+	KeyTab     = tcell.KeyTab
+	KeyBacktab = tcell.KeyBacktab
+
+	// Most terminals send KeyBackspace2 for the
+	// backspace key, and some send KeyBackspace
+	KeyBackspace  = tcell.KeyBackspace
+	KeyBackspace2 = tcell.KeyBackspace2
+
+	KeyInsert = tcell.KeyInsert
+	KeyDelete = tcell.KeyDelete
+	KeyHome   = tcell.KeyHome
+	KeyEnd    = tcell.KeyEnd
+	KeyPgUp   = tcell.KeyPgUp
+	KeyPgDn   = tcell.KeyPgDn
+
+	KeyF1  = tcell.KeyF1
+	KeyF2  = tcell.KeyF2
+	KeyF3  = tcell.KeyF3
+	KeyF4  = tcell.KeyF4
+	KeyF5  = tcell.KeyF5
+	KeyF6  = tcell.KeyF6
+	KeyF7  = tcell.KeyF7
+	KeyF8  = tcell.KeyF8
+	KeyF9  = tcell.KeyF9
+	KeyF10 = tcell.KeyF10
+	KeyF11 = tcell.KeyF11
+	KeyF12 = tcell.KeyF12
+
+	KeyCtrlA = tcell.KeyCtrlA
+	KeyCtrlB = tcell.KeyCtrlB
+	KeyCtrlC = tcell.KeyCtrlC
+	KeyCtrlD = tcell.KeyCtrlD
+	KeyCtrlE = tcell.KeyCtrlE
+	KeyCtrlF = tcell.KeyCtrlF
+	KeyCtrlG = tcell.KeyCtrlG
+	KeyCtrlH = tcell.KeyCtrlH
+	KeyCtrlI = tcell.KeyCtrlI
+	KeyCtrlJ = tcell.KeyCtrlJ
+	KeyCtrlK = tcell.KeyCtrlK
+	KeyCtrlL = tcell.KeyCtrlL
+	KeyCtrlM = tcell.KeyCtrlM
+	KeyCtrlN = tcell.KeyCtrlN
+	KeyCtrlO = tcell.KeyCtrlO
+	KeyCtrlP = tcell.KeyCtrlP
+	KeyCtrlQ = tcell.KeyCtrlQ
+	KeyCtrlR = tcell.KeyCtrlR
+	KeyCtrlS = tcell.KeyCtrlS
+	KeyCtrlT = tcell.KeyCtrlT
+	KeyCtrlU = tcell.KeyCtrlU
+	KeyCtrlV = tcell.KeyCtrlV
+	KeyCtrlW = tcell.KeyCtrlW
+	KeyCtrlX = tcell.KeyCtrlX
+	KeyCtrlY = tcell.KeyCtrlY
+	KeyCtrlZ = tcell.KeyCtrlZ
+)
+
+// Modifier Keys
+const (
+	ModNone  = tcell.ModNone
+	ModShift = tcell.ModShift
+	ModCtrl  = tcell.ModCtrl
+	ModAlt   = tcell.ModAlt
+	ModMeta  = tcell.ModMeta
+)
+
+// Text Attributes
+const (
+	AttrNone      = tcell.AttrNone
+	AttrBold      = tcell.AttrBold
+	AttrUnderline = tcell.AttrUnderline
+	AttrReverse   = tcell.AttrReverse
+	AttrDim       = tcell.AttrDim
+	AttrBlink     = tcell.AttrBlink
+	AttrItalic    = tcell.AttrItalic
+)
+
+// Mouse Buttons
+const (
+	MouseLeft   = tcell.Button1
+	MouseMiddle = tcell.Button2
+	MouseRight  = tcell.Button3
 )
@@ -0,0 +1,253 @@
+// Package terminustest provides a frame-stepping harness
+// for testing terminus games without a terminal
+package terminustest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	t "github.com/Sheep42/terminus"
+	"github.com/gdamore/tcell"
+)
+
+// DefaultDelta is the delta passed to each frame by
+// a Harness, equal to a frame at 60 FPS
+const DefaultDelta = 1.0 / 60.0
+
+var update = flag.Bool("terminustest.update", false, "update terminustest golden files")
+
+// Harness drives a Game one frame at a time using a
+// fixed delta instead of the wall clock
+type Harness struct {
+	game    *t.Game
+	delta   float64
+	frames  int
+	running bool
+}
+
+// NewHarness initializes game with the given scenes and
+// returns a Harness for it, failing the test if game
+// can't be initialized. The game is closed when the
+// test finishes. game should render to a
+// SimulationScreen, see terminus.NewHeadlessGame
+func NewHarness(tb testing.TB, game *t.Game, scenes []t.IScene) *Harness {
+
+	tb.Helper()
+
+	if err := game.Init(scenes); err != nil {
+		tb.Fatalf("initializing game: %v", err)
+	}
+
+	h := &Harness{
+		game:    game,
+		delta:   DefaultDelta,
+		running: true,
+	}
+
+	tb.Cleanup(h.Close)
+
+	return h
+
+}
+
+// Close closes the game, restoring its screen and
+// closing its log file. NewHarness registers this to
+// run when the test finishes
+func (h *Harness) Close() {
+
+	h.game.Close()
+	h.running = false
+
+}
+
+// Game returns the Game driven by the Harness
+func (h *Harness) Game() *t.Game {
+	return h.game
+}
+
+// SetDelta sets the delta passed to each frame
+func (h *Harness) SetDelta(delta float64) {
+	h.delta = delta
+}
+
+// Frames returns the number of frames stepped so far
+func (h *Harness) Frames() int {
+	return h.frames
+}
+
+// Running returns false once the game has exited
+func (h *Harness) Running() bool {
+	return h.running
+}
+
+// Press queues a key event for the game. Every queued
+// event is handled by the next frame, in the order
+// they were pressed
+func (h *Harness) Press(ev *t.KeyEvent) {
+	h.game.PostEvent(ev.EventKey())
+}
+
+// PressKey queues a press of key
+func (h *Harness) PressKey(key t.Key) {
+	h.Press(t.NewKeyEvent(key, 0, t.ModNone))
+}
+
+// PressRune queues a press of the rune r
+func (h *Harness) PressRune(r rune) {
+	h.Press(t.NewKeyEvent(t.KeyRune, r, t.ModNone))
+}
+
+// Mouse queues a mouse event at x, y with the given
+// buttons held. Pass 0 to move the mouse or release
+// every button
+func (h *Harness) Mouse(x, y int, buttons t.ButtonMask) {
+	h.game.PostEvent(tcell.NewEventMouse(x, y, buttons, t.ModNone))
+}
+
+// Click queues a press and release of the left button
+// at x, y
+func (h *Harness) Click(x, y int) {
+
+	h.Mouse(x, y, t.MouseLeft)
+	h.Mouse(x, y, 0)
+
+}
+
+// Resize resizes the simulated screen and notifies
+// the game, which handles the new size on the next
+// frame
+func (h *Harness) Resize(width, height int) {
+
+	if sim, ok := h.game.Screen().(tcell.SimulationScreen); ok {
+		sim.SetSize(width, height)
+	}
+
+	h.game.PostEvent(tcell.NewEventResize(width, height))
+
+}
+
+// Step advances the game by n frames. Returns false
+// if the game has exited
+func (h *Harness) Step(n int) bool {
+
+	for i := 0; i < n && h.running; i++ {
+
+		h.running = h.game.Step(h.delta)
+		h.frames++
+
+	}
+
+	return h.running
+
+}
+
+// Frame returns the frame currently shown on the
+// simulated screen, or nil if the game does not render
+// to a SimulationScreen
+func (h *Harness) Frame() *t.Frame {
+	return h.game.Frame()
+}
+
+// frame returns the current frame, failing the test if
+// there is none
+func (h *Harness) frame(tb testing.TB) *t.Frame {
+
+	tb.Helper()
+
+	frame := h.Frame()
+
+	if nil == frame {
+		tb.Fatalf("no frame, the game does not render to a SimulationScreen")
+	}
+
+	return frame
+
+}
+
+// AssertCell fails the test if the cell at x, y does
+// not contain want
+func (h *Harness) AssertCell(tb testing.TB, x, y int, want rune) {
+
+	tb.Helper()
+
+	c, ok := h.frame(tb).Cell(x, y)
+	if !ok {
+		tb.Errorf("cell (%d, %d) is out of bounds", x, y)
+		return
+	}
+
+	if c.Rune != want {
+		tb.Errorf("cell (%d, %d) = %q, want %q", x, y, c.Rune, want)
+	}
+
+}
+
+// AssertRow fails the test if row y does not read
+// want. Trailing spaces are ignored
+func (h *Harness) AssertRow(tb testing.TB, y int, want string) {
+
+	tb.Helper()
+
+	got := strings.TrimRight(h.frame(tb).Row(y), " ")
+	want = strings.TrimRight(want, " ")
+
+	if got != want {
+		tb.Errorf("row %d = %q, want %q", y, got, want)
+	}
+
+}
+
+// AssertFrame fails the test if the current frame does
+// not match the golden file at path. Trailing spaces on
+// each row are ignored. Run the tests with the flag
+// -terminustest.update to write the current frame to
+// the golden file instead
+func (h *Harness) AssertFrame(tb testing.TB, path string) {
+
+	tb.Helper()
+
+	got := trimFrame(h.frame(tb).String())
+
+	if *update {
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			tb.Fatalf("creating golden file directory: %v", err)
+		}
+
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			tb.Fatalf("writing golden file: %v", err)
+		}
+
+		return
+
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("reading golden file: %v", err)
+	}
+
+	want := trimFrame(string(b))
+
+	if got != want {
+		tb.Errorf("frame does not match %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+
+}
+
+// trimFrame removes trailing spaces from each row and
+// trailing empty rows from the frame text
+func trimFrame(frame string) string {
+
+	rows := strings.Split(strings.ReplaceAll(frame, "\r\n", "\n"), "\n")
+
+	for i, row := range rows {
+		rows[i] = strings.TrimRight(row, " ")
+	}
+
+	return strings.TrimRight(strings.Join(rows, "\n"), "\n") + "\n"
+
+}
@@ -0,0 +1,177 @@
+package terminustest
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	t "github.com/Sheep42/terminus"
+	"github.com/gdamore/tcell"
+)
+
+// newHelloHarness returns a Harness for a small game
+// showing a box with a greeting in it
+func newHelloHarness(tb testing.TB) *Harness {
+
+	tb.Helper()
+
+	g := t.NewHeadlessGame(12, 4)
+
+	s := t.NewScene(g)
+	s.Add(t.NewText(0, 0, "+----------+"))
+	s.Add(t.NewText(0, 1, "| Hello 日 |"))
+	s.Add(t.NewText(0, 2, "+----------+"))
+
+	h := NewHarness(tb, g, []t.IScene{s})
+	h.Step(1)
+
+	return h
+
+}
+
+func TestAssertFrame(tb *testing.T) {
+
+	h := newHelloHarness(tb)
+
+	h.AssertFrame(tb, filepath.Join("testdata", "hello.golden"))
+
+}
+
+func TestAssertRow(tb *testing.T) {
+
+	h := newHelloHarness(tb)
+
+	h.AssertRow(tb, 1, "| Hello 日 |")
+	h.AssertRow(tb, 3, "")
+	h.AssertCell(tb, 2, 1, 'H')
+	h.AssertCell(tb, 8, 1, '日')
+
+}
+
+func TestStepExit(tb *testing.T) {
+
+	h := newHelloHarness(tb)
+
+	if !h.Running() || 1 != h.Frames() {
+		tb.Fatalf("after one frame Running = %v, Frames = %d", h.Running(), h.Frames())
+	}
+
+	h.PressKey(t.KeyEsc)
+
+	if h.Step(5) {
+		tb.Error("Step returned true after the exit key was pressed")
+	}
+
+	if h.Running() {
+		tb.Error("Running returned true after the exit key was pressed")
+	}
+
+	// no frames are stepped once the game has exited
+	if frames := h.Frames(); 2 != frames {
+		tb.Errorf("Frames = %d, want 2", frames)
+	}
+
+}
+
+func TestResize(tb *testing.T) {
+
+	h := newHelloHarness(tb)
+
+	h.Resize(20, 6)
+	h.Step(1)
+
+	if width, height := h.Frame().Size(); 20 != width || 6 != height {
+		tb.Errorf("frame size = %d, %d, want 20, 6", width, height)
+	}
+
+	if width, height := h.Game().ScreenSize(); 20 != width || 6 != height {
+		tb.Errorf("screen size = %d, %d, want 20, 6", width, height)
+	}
+
+	h.AssertRow(tb, 1, "| Hello 日 |")
+
+}
+
+func TestTrimFrame(tb *testing.T) {
+
+	tests := []struct {
+		frame string
+		want  string
+	}{
+		{"", "\n"},
+		{"ab  \ncd\n  \n\n", "ab\ncd\n"},
+		{"ab\r\n  cd \r\n", "ab\n  cd\n"},
+	}
+
+	for _, test := range tests {
+
+		if got := trimFrame(test.frame); got != test.want {
+			tb.Errorf("trimFrame(%q) = %q, want %q", test.frame, got, test.want)
+		}
+
+	}
+
+}
+
+// fatalRecorder is a testing.TB which records a call
+// to Fatalf instead of stopping the test
+type fatalRecorder struct {
+	testing.TB
+	fatal string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(format string, args ...interface{}) {
+
+	r.fatal = fmt.Sprintf(format, args...)
+
+	panic(r)
+
+}
+
+// wrappedScreen hides the SimulationScreen behind it,
+// like a terminal screen
+type wrappedScreen struct {
+	tcell.Screen
+}
+
+func TestAssertWithoutFrame(tb *testing.T) {
+
+	g := t.NewGame()
+	g.SetScreen(wrappedScreen{tcell.NewSimulationScreen("UTF-8")})
+
+	h := NewHarness(tb, g, []t.IScene{t.NewScene(g)})
+	h.Step(1)
+
+	assertions := map[string]func(testing.TB){
+		"AssertCell":  func(r testing.TB) { h.AssertCell(r, 0, 0, ' ') },
+		"AssertRow":   func(r testing.TB) { h.AssertRow(r, 0, "") },
+		"AssertFrame": func(r testing.TB) { h.AssertFrame(r, filepath.Join("testdata", "hello.golden")) },
+	}
+
+	for name, assert := range assertions {
+
+		recorder := &fatalRecorder{TB: tb}
+
+		func() {
+
+			defer func() {
+
+				if r := recover(); r != recorder {
+					panic(r)
+				}
+
+			}()
+
+			assert(recorder)
+
+		}()
+
+		if "" == recorder.fatal {
+			tb.Errorf("%s did not fail without a frame", name)
+		}
+
+	}
+
+}
@@ -1,31 +1,106 @@
 package terminus
 
-import "github.com/gdamore/tcell"
-
 // IText is the interface through which custom
 // implementations of Text can be created
 type IText interface {
 	ToEntities(text string) []IEntity
 }
 
+// WrapMode is how Text breaks lines which are wider
+// than its box
+type WrapMode int
+
+// Wrap Modes
+const (
+	WrapNone WrapMode = iota
+	WrapWord
+	WrapChar
+)
+
+// Align is how lines of Text are lined up within
+// the width of its box
+type Align int
+
+// Alignments
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// VerticalAlign is how the lines of Text are lined
+// up within the height of its box
+type VerticalAlign int
+
+// Vertical Alignments
+const (
+	AlignTop VerticalAlign = iota
+	AlignMiddle
+	AlignBottom
+)
+
+// Overflow is what Text does with lines that do not
+// fit in its box
+type Overflow int
+
+// Overflow Modes
+const (
+	// OverflowClip cuts off whatever does not fit
+	OverflowClip Overflow = iota
+
+	// OverflowEllipsis cuts off whatever does not fit
+	// and ends the last line shown with an ellipsis
+	OverflowEllipsis
+
+	// OverflowScroll shows the lines from the scroll
+	// position, see Text.Scroll
+	OverflowScroll
+)
+
 // Text is a type of EntityGroup which is used
 // to render text to the game screen
 type Text struct {
 	*EntityGroup
-	text string
+	text     string
+	markup   string
+	chars    []grapheme
+	gradient *Gradient
+
+	boxWidth  int
+	boxHeight int
+	wrap      WrapMode
+	align     Align
+	valign    VerticalAlign
+	overflow  Overflow
+	scroll    int
 }
 
 // NewText takes an x position, y position, and text
-// value and creates a new Text Entity on the screen
-func NewText(x, y int, text string, colors ...tcell.Color) *Text {
-
-	entities := ToEntities(text)
+// value and creates a new Text Entity on the screen.
+// A newline in text starts a new line
+func NewText(x, y int, text string, colors ...Color) *Text {
 
 	t := &Text{
-		EntityGroup: NewEntityGroup(x, y, len(text), 1, entities, colors...),
+		EntityGroup: NewEntityGroup(x, y, 0, 0, []IEntity{}, colors...),
 		text:        text,
+		chars:       graphemes(text),
 	}
 
+	t.layout()
+
+	return t
+
+}
+
+// NewRichText takes an x position, y position, and text
+// with markup tags, and creates a new Text Entity on the
+// screen with the colors and attributes of the tags.
+// See SetMarkup
+func NewRichText(x, y int, markup string, colors ...Color) *Text {
+
+	t := NewText(x, y, "", colors...)
+	t.SetMarkup(markup)
+
 	return t
 
 }
@@ -37,15 +112,39 @@ func (t *Text) Update(delta float64) {
 }
 
 // ToEntities returns a slice of entities
-// representing a given string of text
+// representing a given string of text, one for
+// each character seen on the screen. Wide
+// characters take up two columns, and accents
+// and emoji sequences are kept together
 func ToEntities(text string) []IEntity {
+	return graphemeEntities(graphemes(text))
+}
+
+// MarkupToEntities returns a slice of entities
+// representing text with markup tags, each with
+// the Style given by the tags
+func MarkupToEntities(markup string) []IEntity {
+	return graphemeEntities(parseMarkup(markup))
+}
+
+// graphemeEntities returns an entity for each of
+// chars, placed one after the other
+func graphemeEntities(chars []grapheme) []IEntity {
 
 	entities := []IEntity{}
+	x := 0
+
+	for _, g := range chars {
 
-	for index, char := range text {
 		// 0,0 starts from top left of the
 		// EntityGroup
-		entities = append(entities, NewSpriteEntity(index, 0, rune(char)))
+		entity := NewSpriteEntity(x, 0, g.r)
+		entity.combining = g.combining
+		entity.style = g.style
+
+		entities = append(entities, entity)
+		x += g.width
+
 	}
 
 	return entities
@@ -55,17 +154,546 @@ func ToEntities(text string) []IEntity {
 func (t *Text) SetText(newText string) {
 
 	t.text = newText
-	t.SetEntities(ToEntities(newText))
-	t.EntityGroup.SetWidth(len(newText))
-	t.scene.redraw = true
+	t.markup = ""
+	t.chars = graphemes(newText)
+	t.layout()
 
 }
 
-// GetText gets the text value of the Text Entity
+// GetText gets the text value of the Text Entity,
+// without any markup tags
 func (t *Text) GetText() string {
 	return t.text
 }
 
+// SetMarkup sets the text value of the Text Entity from
+// text with markup tags, which set the colors and
+// attributes of the characters after them:
+//
+//	[red]              foreground color, by name or hex
+//	[#ff8800:black]    foreground and background colors
+//	[:blue]            background color
+//	[-:-], [red:-]     go back to the color of the Text
+//	[b] [i] [u] [d]    bold, italic, underline and dim,
+//	[r] [blink]        reverse and blink
+//	[/b] [/i] ...      turn an attribute off
+//	[-]                go back to the style of the Text
+//	[[                 a literal [
+//
+// Anything in brackets that is not a tag is shown as it is
+func (t *Text) SetMarkup(markup string) {
+
+	t.markup = markup
+	t.chars = parseMarkup(markup)
+	t.text = graphemesText(t.chars)
+	t.layout()
+
+}
+
+// GetMarkup gets the markup of the Text Entity, or an
+// empty string if it was set with SetText
+func (t *Text) GetMarkup() string {
+	return t.markup
+}
+
+// SetBox sets the size of the box that the Text is
+// laid out in. A width of 0 fits the widest line, and
+// a height of 0 fits every line. Lines are only
+// wrapped when the box has a width
+func (t *Text) SetBox(width, height int) {
+
+	t.boxWidth, t.boxHeight = width, height
+	t.layout()
+
+}
+
+// SetWidth sets the width of the box that the Text is
+// laid out in, see SetBox
+func (t *Text) SetWidth(width int) {
+
+	t.boxWidth = width
+	t.layout()
+
+}
+
+// SetHeight sets the height of the box that the Text
+// is laid out in, see SetBox
+func (t *Text) SetHeight(height int) {
+
+	t.boxHeight = height
+	t.layout()
+
+}
+
+// GetBox gets the width and height of the box that
+// the Text is laid out in
+func (t *Text) GetBox() (int, int) {
+	return t.boxWidth, t.boxHeight
+}
+
+// SetWrap sets how lines wider than the box are broken
+func (t *Text) SetWrap(wrap WrapMode) {
+
+	t.wrap = wrap
+	t.layout()
+
+}
+
+// GetWrap gets how lines wider than the box are broken
+func (t *Text) GetWrap() WrapMode {
+	return t.wrap
+}
+
+// SetAlign sets how lines are lined up within the
+// width of the box
+func (t *Text) SetAlign(align Align) {
+
+	t.align = align
+	t.layout()
+
+}
+
+// GetAlign gets how lines are lined up within the
+// width of the box
+func (t *Text) GetAlign() Align {
+	return t.align
+}
+
+// SetVerticalAlign sets how the lines are lined up
+// within the height of the box
+func (t *Text) SetVerticalAlign(valign VerticalAlign) {
+
+	t.valign = valign
+	t.layout()
+
+}
+
+// GetVerticalAlign gets how the lines are lined up
+// within the height of the box
+func (t *Text) GetVerticalAlign() VerticalAlign {
+	return t.valign
+}
+
+// SetOverflow sets what is done with lines that do not
+// fit in the box
+func (t *Text) SetOverflow(overflow Overflow) {
+
+	t.overflow = overflow
+	t.layout()
+
+}
+
+// GetOverflow gets what is done with lines that do not
+// fit in the box
+func (t *Text) GetOverflow() Overflow {
+	return t.overflow
+}
+
+// Scroll moves the lines shown by OverflowScroll down
+// by lines, or up for negative lines. Scrolling stops
+// at the first and last lines
+func (t *Text) Scroll(lines int) {
+	t.SetScroll(t.scroll + lines)
+}
+
+// SetScroll sets the first line shown by OverflowScroll
+func (t *Text) SetScroll(line int) {
+
+	t.scroll = line
+	t.layout()
+
+}
+
+// GetScroll gets the first line shown by OverflowScroll
+func (t *Text) GetScroll() int {
+	return t.scroll
+}
+
+// GetLines returns the lines of the Text after it has
+// been wrapped, including any that do not fit in the box
+func (t *Text) GetLines() []string {
+
+	lines := []string{}
+
+	for _, line := range t.lines() {
+		lines = append(lines, graphemesText(line))
+	}
+
+	return lines
+
+}
+
+// lines splits the characters of the Text into lines
+// at newlines, and wraps them to the width of the box
+func (t *Text) lines() [][]grapheme {
+
+	lines := [][]grapheme{}
+	line := []grapheme{}
+
+	for i := 0; i <= len(t.chars); i++ {
+
+		if i < len(t.chars) && '\n' != t.chars[i].r {
+
+			if '\r' != t.chars[i].r {
+				line = append(line, t.chars[i])
+			}
+
+			continue
+
+		}
+
+		switch {
+		case t.boxWidth <= 0 || WrapNone == t.wrap:
+			lines = append(lines, line)
+		case WrapWord == t.wrap:
+			lines = append(lines, wrapWords(line, t.boxWidth)...)
+		default:
+			lines = append(lines, wrapChars(line, t.boxWidth)...)
+		}
+
+		line = []grapheme{}
+
+	}
+
+	return lines
+
+}
+
+// layout rebuilds the characters of the Text from its
+// text, box, wrapping, alignment and overflow
+func (t *Text) layout() {
+
+	lines := t.lines()
+	width, height := t.boxWidth, t.boxHeight
+
+	if width <= 0 {
+
+		width = 0
+
+		for _, line := range lines {
+
+			if w := graphemesWidth(line); w > width {
+				width = w
+			}
+
+		}
+
+	}
+
+	if height <= 0 {
+		height = len(lines)
+	}
+
+	// lines that do not fit in the height of the box
+	shown := lines
+	t.scroll = clampInt(t.scroll, 0, len(lines)-height)
+
+	if len(lines) > height {
+
+		switch t.overflow {
+		case OverflowScroll:
+			shown = lines[t.scroll : t.scroll+height]
+		case OverflowEllipsis:
+
+			shown = append([][]grapheme{}, lines[:height]...)
+
+			if last := height - 1; last >= 0 {
+				shown[last] = ellipsis(shown[last], width, true)
+			}
+
+		default:
+			shown = lines[:height]
+		}
+
+	}
+
+	top := 0
+
+	switch t.valign {
+	case AlignMiddle:
+		top = (height - len(shown)) / 2
+	case AlignBottom:
+		top = height - len(shown)
+	}
+
+	entities := []IEntity{}
+
+	for row, line := range shown {
+
+		// lines that do not fit in the width of the box
+		if graphemesWidth(line) > width {
+
+			if OverflowEllipsis == t.overflow {
+				line = ellipsis(line, width, false)
+			} else {
+				line = clip(line, width)
+			}
+
+		}
+
+		left := 0
+
+		switch t.align {
+		case AlignCenter:
+			left = (width - graphemesWidth(line)) / 2
+		case AlignRight:
+			left = width - graphemesWidth(line)
+		}
+
+		for _, e := range graphemeEntities(line) {
+
+			entity := e.GetEntity()
+			entity.x += left
+			entity.y = top + row
+
+			entities = append(entities, e)
+
+		}
+
+	}
+
+	t.width, t.height = width, height
+	t.SetEntities(entities)
+	t.applyGradient()
+
+}
+
+// wrapWords breaks line into lines no wider than width
+// between words. Words wider than width are broken
+// between characters
+func wrapWords(line []grapheme, width int) [][]grapheme {
+
+	lines := [][]grapheme{}
+	current, started := []grapheme{}, false
+
+	for _, word := range splitWords(line) {
+
+		// spaces at the start of a wrapped line
+		// are dropped
+		if !started && 0 == len(word) && len(lines) > 0 {
+			continue
+		}
+
+		if started && graphemesWidth(current)+1+graphemesWidth(word) > width {
+
+			lines = append(lines, current)
+			current, started = []grapheme{}, false
+
+			if 0 == len(word) {
+				continue
+			}
+
+		}
+
+		if started {
+			current = append(current, grapheme{r: ' ', width: 1, style: spaceStyle(current, word)})
+		}
+
+		current = append(current, word...)
+		started = true
+
+		if graphemesWidth(current) > width {
+
+			chunks := wrapChars(current, width)
+			lines = append(lines, chunks[:len(chunks)-1]...)
+			current = chunks[len(chunks)-1]
+
+		}
+
+	}
+
+	return append(lines, current)
+
+}
+
+// splitWords splits line at each space
+func splitWords(line []grapheme) [][]grapheme {
+
+	words := [][]grapheme{}
+	word := []grapheme{}
+
+	for _, g := range line {
+
+		if ' ' == g.r && 0 == len(g.combining) {
+			words = append(words, word)
+			word = []grapheme{}
+			continue
+		}
+
+		word = append(word, g)
+
+	}
+
+	return append(words, word)
+
+}
+
+// spaceStyle returns the Style of the space put back
+// between two words, which is the Style of the end of
+// the first word, so that a background carries on
+func spaceStyle(before, after []grapheme) Style {
+
+	if len(before) > 0 {
+		return before[len(before)-1].style
+	}
+
+	if len(after) > 0 {
+		return after[0].style
+	}
+
+	return Style{}
+
+}
+
+// wrapChars breaks line into lines no wider than
+// width between characters
+func wrapChars(line []grapheme, width int) [][]grapheme {
+
+	lines := [][]grapheme{}
+	current, currentWidth := []grapheme{}, 0
+
+	for _, g := range line {
+
+		if currentWidth > 0 && currentWidth+g.width > width {
+			lines = append(lines, current)
+			current, currentWidth = []grapheme{}, 0
+		}
+
+		current = append(current, g)
+		currentWidth += g.width
+
+	}
+
+	return append(lines, current)
+
+}
+
+// clip cuts line down to width
+func clip(line []grapheme, width int) []grapheme {
+
+	result, resultWidth := []grapheme{}, 0
+
+	for _, g := range line {
+
+		if resultWidth+g.width > width {
+			break
+		}
+
+		result = append(result, g)
+		resultWidth += g.width
+
+	}
+
+	return result
+
+}
+
+// ellipsis ends line with an ellipsis, cutting it down
+// to fit in width. Unless more is true, a line which
+// already fits is left as it is
+func ellipsis(line []grapheme, width int, more bool) []grapheme {
+
+	if width <= 0 || (!more && graphemesWidth(line) <= width) {
+		return clip(line, width)
+	}
+
+	style := Style{}
+
+	if len(line) > 0 {
+		style = line[len(line)-1].style
+	}
+
+	if graphemesWidth(line) >= width {
+		line = clip(line, width-1)
+	}
+
+	// trailing spaces before the ellipsis are dropped
+	for len(line) > 0 && ' ' == line[len(line)-1].r {
+		line = line[:len(line)-1]
+	}
+
+	return append(append([]grapheme{}, line...), grapheme{r: '…', width: 1, style: style})
+
+}
+
+// graphemesWidth returns the number of cells line takes
+// up on the screen
+func graphemesWidth(line []grapheme) int {
+
+	width := 0
+
+	for _, g := range line {
+		width += g.width
+	}
+
+	return width
+
+}
+
+// graphemesText joins line back into a string
+func graphemesText(line []grapheme) string {
+
+	text := ""
+
+	for _, g := range line {
+		text += g.text()
+	}
+
+	return text
+
+}
+
+// clampInt keeps n between min and max. If max is less
+// than min, min is returned
+func clampInt(n, min, max int) int {
+
+	if n > max {
+		n = max
+	}
+
+	if n < min {
+		n = min
+	}
+
+	return n
+
+}
+
+// SetGradient colors the characters of the Text along
+// gradient, from the left edge of the Text to the right,
+// so every line has the same colors in the same columns.
+// Pass nil to go back to the color of the Text
+func (t *Text) SetGradient(gradient *Gradient) {
+
+	t.gradient = gradient
+	t.layout()
+
+}
+
+// GetGradient gets the gradient of the Text, or nil
+// if there is none
+func (t *Text) GetGradient() *Gradient {
+	return t.gradient
+}
+
+// applyGradient sets the foreground color of each
+// character from the gradient, by its column in the
+// laid out width of the Text
+func (t *Text) applyGradient() {
+
+	if nil == t.gradient {
+		return
+	}
+
+	for _, e := range t.GetEntities() {
+
+		entity := e.GetEntity()
+		entity.style = entity.style.Foreground(t.gradient.At(gradientAmount(entity.x, t.width)))
+
+	}
+
+}
+
 // GetEntityGroup gets the EntityGroup that contain
 // the Text Entities
 func (t *Text) GetEntityGroup() *EntityGroup {
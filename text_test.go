@@ -0,0 +1,229 @@
+package terminus
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTextGradientByColumn(t *testing.T) {
+
+	red, blue := ColorHex("#ff0000"), ColorHex("#0000ff")
+
+	text := NewText(0, 0, "abcd\nab\nabcde")
+	text.SetGradient(NewGradient(red, blue))
+
+	width, _ := text.GetDimensions()
+	gradient := text.GetGradient()
+
+	for _, e := range text.GetEntities() {
+
+		entity := e.GetEntity()
+		fg, _ := entity.style.GetForeground()
+
+		if want := gradient.At(gradientAmount(entity.x, width)); fg != want {
+			t.Errorf("character at %d, %d is colored %v, want %v", entity.x, entity.y, fg, want)
+		}
+
+	}
+
+	first, _ := text.GetEntities()[0].GetEntity().style.GetForeground()
+	last, _ := text.GetEntities()[len(text.GetEntities())-1].GetEntity().style.GetForeground()
+
+	if red != first || blue != last {
+		t.Errorf("gradient runs from %v to %v, want %v to %v", first, last, red, blue)
+	}
+
+}
+
+// shownRows returns the text drawn on each row of the
+// Text, from its entities
+func shownRows(text *Text) []string {
+
+	_, height := text.GetDimensions()
+	rows := make([]string, height)
+
+	for _, e := range text.GetEntities() {
+
+		entity := e.GetEntity()
+		rows[entity.y] += string(entity.GetSprite())
+
+	}
+
+	return rows
+
+}
+
+func TestTextSetWidthAndHeight(t *testing.T) {
+
+	text := NewText(0, 0, "hello world")
+	text.SetWrap(WrapWord)
+
+	tests := []struct {
+		name   string
+		set    func()
+		width  int
+		height int
+		want   []string
+	}{
+		{"width wraps", func() { text.SetWidth(5) }, 5, 2, []string{"hello", "world"}},
+		{"height clips lines", func() { text.SetHeight(1) }, 5, 1, []string{"hello"}},
+		{"width without wrapping clips", func() { text.SetWrap(WrapNone); text.SetWidth(4) }, 4, 1, []string{"hell"}},
+		{"zero fits the text", func() { text.SetWidth(0); text.SetHeight(0) }, 11, 1, []string{"hello world"}},
+	}
+
+	for _, test := range tests {
+
+		test.set()
+
+		if width, height := text.GetDimensions(); width != test.width || height != test.height {
+			t.Errorf("%s: dimensions %d, %d, want %d, %d", test.name, width, height, test.width, test.height)
+			continue
+		}
+
+		rows := shownRows(text)
+
+		for i := range test.want {
+
+			if rows[i] != test.want[i] {
+				t.Errorf("%s: row %d is %q, want %q", test.name, i, rows[i], test.want[i])
+			}
+
+		}
+
+	}
+
+}
+
+// joinLines joins the text of lines with "|"
+func joinLines(lines [][]grapheme) string {
+
+	texts := []string{}
+
+	for _, line := range lines {
+		texts = append(texts, graphemesText(line))
+	}
+
+	return strings.Join(texts, "|")
+
+}
+
+func TestWrapWords(t *testing.T) {
+
+	tests := []struct {
+		line  string
+		width int
+		want  string
+	}{
+		{"hello world", 5, "hello|world"},
+		{"a b c", 3, "a b|c"},
+		{"a  b", 10, "a  b"},
+		{"hello  world", 5, "hello|world"},
+		{"", 5, ""},
+		{"abcdefgh", 3, "abc|def|gh"},
+		{"hi abcdefgh", 3, "hi|abc|def|gh"},
+		{"日本 語", 4, "日本|語"},
+		{"日本語", 4, "日本|語"},
+		{"日本語", 1, "日|本|語"},
+		{"cafe\u0301 au lait", 4, "cafe\u0301|au|lait"},
+		{"ab", 0, "a|b"},
+		{"a b", 0, "a|b"},
+	}
+
+	for _, test := range tests {
+
+		if got := joinLines(wrapWords(graphemes(test.line), test.width)); got != test.want {
+			t.Errorf("wrapWords(%q, %d) = %q, want %q", test.line, test.width, got, test.want)
+		}
+
+	}
+
+}
+
+func TestWrapChars(t *testing.T) {
+
+	tests := []struct {
+		line  string
+		width int
+		want  string
+	}{
+		{"abcdef", 4, "abcd|ef"},
+		{"ab cd", 3, "ab |cd"},
+		{"", 3, ""},
+		{"日本語", 3, "日|本|語"},
+		{"a日", 2, "a|日"},
+		{"日本語", 1, "日|本|語"},
+		{"cafe\u0301s", 4, "cafe\u0301|s"},
+		{"ab", 0, "a|b"},
+	}
+
+	for _, test := range tests {
+
+		if got := joinLines(wrapChars(graphemes(test.line), test.width)); got != test.want {
+			t.Errorf("wrapChars(%q, %d) = %q, want %q", test.line, test.width, got, test.want)
+		}
+
+	}
+
+}
+
+func TestClip(t *testing.T) {
+
+	tests := []struct {
+		line  string
+		width int
+		want  string
+	}{
+		{"hello", 3, "hel"},
+		{"abc", 5, "abc"},
+		{"", 3, ""},
+		{"日本", 3, "日"},
+		{"日本", 1, ""},
+		{"abc", 0, ""},
+	}
+
+	for _, test := range tests {
+
+		if got := graphemesText(clip(graphemes(test.line), test.width)); got != test.want {
+			t.Errorf("clip(%q, %d) = %q, want %q", test.line, test.width, got, test.want)
+		}
+
+	}
+
+}
+
+func TestEllipsis(t *testing.T) {
+
+	tests := []struct {
+		line  string
+		width int
+		more  bool
+		want  string
+	}{
+		{"hello world", 5, false, "hell…"},
+		{"hi", 5, false, "hi"},
+		{"hi", 5, true, "hi…"},
+		{"hello", 5, true, "hell…"},
+		{"ab cd", 4, false, "ab…"},
+		{"日本語", 4, false, "日…"},
+		{"", 3, true, "…"},
+		{"", 3, false, ""},
+		{"abc", 1, false, "…"},
+		{"abc", 0, false, ""},
+		{"abc", 0, true, ""},
+	}
+
+	for _, test := range tests {
+
+		got := graphemesText(ellipsis(graphemes(test.line), test.width, test.more))
+
+		if got != test.want {
+			t.Errorf("ellipsis(%q, %d, %v) = %q, want %q", test.line, test.width, test.more, got, test.want)
+		}
+
+		if width := graphemesWidth(graphemes(got)); width > test.width {
+			t.Errorf("ellipsis(%q, %d, %v) is %d wide", test.line, test.width, test.more, width)
+		}
+
+	}
+
+}
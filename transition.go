@@ -0,0 +1,260 @@
+package terminus
+
+import (
+	"math/rand"
+
+	"github.com/gdamore/tcell"
+)
+
+// TransitionKind determines how a Transition blends
+// the outgoing scene into the incoming scene
+type TransitionKind int
+
+// Transition kinds
+const (
+	// TransitionFade fades the outgoing scene to black,
+	// then fades the incoming scene in from black
+	TransitionFade TransitionKind = iota
+
+	// TransitionWipeHorizontal reveals the incoming scene
+	// from left to right
+	TransitionWipeHorizontal
+
+	// TransitionWipeVertical reveals the incoming scene
+	// from top to bottom
+	TransitionWipeVertical
+
+	// TransitionSlide slides the incoming scene in from
+	// the right, pushing the outgoing scene out
+	TransitionSlide
+
+	// TransitionDissolve replaces the outgoing scene with
+	// the incoming scene one random cell at a time
+	TransitionDissolve
+)
+
+// Transition animates scene switches by capturing the
+// outgoing and incoming frames and blending them over
+// a duration
+type Transition struct {
+	kind       TransitionKind
+	duration   float64
+	blockInput bool
+
+	from    *Frame
+	to      *Frame
+	elapsed float64
+	order   []int
+}
+
+// NewTransition creates a Transition of the given kind
+// which lasts for duration seconds. Input is blocked
+// while the Transition runs by default
+func NewTransition(kind TransitionKind, duration float64) *Transition {
+
+	transition := &Transition{
+		kind:       kind,
+		duration:   duration,
+		blockInput: true,
+	}
+
+	return transition
+
+}
+
+// GetKind gets the kind of the Transition
+func (transition *Transition) GetKind() TransitionKind {
+	return transition.kind
+}
+
+// GetDuration gets the duration of the Transition
+// in seconds
+func (transition *Transition) GetDuration() float64 {
+	return transition.duration
+}
+
+// SetBlockInput sets whether game input is ignored
+// while the Transition runs
+func (transition *Transition) SetBlockInput(blockInput bool) {
+	transition.blockInput = blockInput
+}
+
+// BlocksInput checks if game input is ignored while
+// the Transition runs
+func (transition *Transition) BlocksInput() bool {
+	return transition.blockInput
+}
+
+// start begins blending from into to
+func (transition *Transition) start(from, to *Frame) {
+
+	transition.from = from
+	transition.to = to
+	transition.elapsed = 0
+
+	if transition.kind == TransitionDissolve {
+
+		width, height := to.Size()
+		transition.order = rand.Perm(width * height)
+
+	}
+
+}
+
+// advance moves the Transition forward by delta.
+// Returns false once it has finished
+func (transition *Transition) advance(delta float64) bool {
+
+	transition.elapsed += delta
+
+	return transition.elapsed < transition.duration
+
+}
+
+// progress returns how far along the Transition
+// is, from 0 to 1
+func (transition *Transition) progress() float64 {
+
+	if transition.duration <= 0 {
+		return 1
+	}
+
+	p := transition.elapsed / transition.duration
+
+	if p > 1 {
+		return 1
+	}
+
+	return p
+
+}
+
+// blend returns the frame to show at the current
+// point of the Transition
+func (transition *Transition) blend() *Frame {
+
+	from, to := transition.from, transition.to
+	width, height := to.Size()
+	frame := NewFrame(width, height)
+	p := transition.progress()
+	count := int(p * float64(width*height))
+
+	for y := 0; y < height; y++ {
+
+		for x := 0; x < width; x++ {
+
+			var cell Cell
+
+			switch transition.kind {
+
+			case TransitionFade:
+
+				if p < 0.5 {
+					cell, _ = from.Cell(x, y)
+					cell.Style = fadeStyle(cell.Style, 1-p*2)
+				} else {
+					cell, _ = to.Cell(x, y)
+					cell.Style = fadeStyle(cell.Style, p*2-1)
+				}
+
+			case TransitionWipeHorizontal:
+
+				if float64(x) < p*float64(width) {
+					cell, _ = to.Cell(x, y)
+				} else {
+					cell, _ = from.Cell(x, y)
+				}
+
+			case TransitionWipeVertical:
+
+				if float64(y) < p*float64(height) {
+					cell, _ = to.Cell(x, y)
+				} else {
+					cell, _ = from.Cell(x, y)
+				}
+
+			case TransitionSlide:
+
+				offset := int(p * float64(width))
+
+				if x+offset < width {
+					cell, _ = from.Cell(x+offset, y)
+				} else {
+					cell, _ = to.Cell(x+offset-width, y)
+				}
+
+			case TransitionDissolve:
+
+				if transition.order[y*width+x] < count {
+					cell, _ = to.Cell(x, y)
+				} else {
+					cell, _ = from.Cell(x, y)
+				}
+
+			}
+
+			if 0 == cell.Rune {
+				cell.Rune = ' '
+			}
+
+			frame.SetCell(x, y, cell)
+
+		}
+
+	}
+
+	return frame
+
+}
+
+// fadeStyle scales the colors of style towards black,
+// where a brightness of 0 is black and 1 is unchanged
+func fadeStyle(style tcell.Style, brightness float64) tcell.Style {
+
+	fg, bg, attrs := style.Decompose()
+
+	if fg == tcell.ColorDefault {
+		fg = tcell.ColorWhite
+	}
+
+	if bg == tcell.ColorDefault {
+		bg = tcell.ColorBlack
+	}
+
+	faded := tcell.StyleDefault.
+		Foreground(fadeColor(fg, brightness)).
+		Background(fadeColor(bg, brightness))
+
+	return withAttrs(faded, attrs)
+
+}
+
+// fadeColor scales color towards black
+func fadeColor(color tcell.Color, brightness float64) tcell.Color {
+
+	r, g, b := color.RGB()
+
+	if r < 0 {
+		return color
+	}
+
+	return tcell.NewRGBColor(
+		int32(float64(r)*brightness),
+		int32(float64(g)*brightness),
+		int32(float64(b)*brightness),
+	)
+
+}
+
+// withAttrs returns style with the given attributes
+func withAttrs(style tcell.Style, attrs tcell.AttrMask) tcell.Style {
+
+	return style.
+		Bold(attrs&tcell.AttrBold != 0).
+		Blink(attrs&tcell.AttrBlink != 0).
+		Reverse(attrs&tcell.AttrReverse != 0).
+		Underline(attrs&tcell.AttrUnderline != 0).
+		Dim(attrs&tcell.AttrDim != 0).
+		Italic(attrs&tcell.AttrItalic != 0)
+
+}